@@ -8,6 +8,24 @@ import json
 logging.basicConfig(level=logging.INFO, format='%(levelname)s:%(name)s:%(message)s')
 logger = logging.getLogger(__name__)
 
+def numeric(value, unit, low=None, high=None):
+    """數值結果，依參考範圍判讀 N/L/H"""
+    reference_range = {}
+    if low is not None:
+        reference_range["low"] = low
+    if high is not None:
+        reference_range["high"] = high
+    flag = "N"
+    if low is not None and value < low:
+        flag = "L"
+    elif high is not None and value > high:
+        flag = "H"
+    return {"value": value, "unit": unit, "referenceRange": reference_range, "flag": flag}
+
+def qualitative(text, reference_text):
+    """定性結果（非數值判讀），判讀固定為 A"""
+    return {"text": text, "referenceRange": {"text": reference_text}, "flag": "A"}
+
 def run():
     # 連接到 gRPC 服務器
     try:
@@ -16,64 +34,65 @@ def run():
 
         # 測試報告 ID
         report_id = "report001"
-        test_results_json = '''
-        {
-            "Glu-AC": "89 mg/dL",
-            "HbA1c": "4.1 %",
-            "Glu-PC": "124 mg/dL",
-            "Alb": "4.5 g/dL",
-            "TP": "6.5 g/dL",
-            "AST（GOT）": "27 U/L",
-            "ALT（GPT）": "10 U/L",
-            "D-Bil": "0.03 mg/dL",
-            "ALP": "74 U/L",
-            "T-Bil": "0.7 mg/dL",
-            "UN": "23 mg/dL",
-            "CRE": "1.2 mg/dL",
-            "U.A": "4.9 mg/dL",
-            "T-CHO": "164 mg/dL",
-            "LDL-C": "128 mg/dL",
-            "HDL-C": "54 mg/dL",
-            "TG": "143 mg/dL",
-            "Hb": "13.3 g/dL",
-            "Hct": "49.7 %",
-            "PLT": "286 x10^3/uL",
-            "WBC": "4.04 x10^3/uL",
-            "RBC": "4.56 x10^6/uL",
-            "hsCRP": "0.38 mg/dL",
-            "AFP": "14 ng/mL",
-            "CEA": "2.8 ng/mL",
-            "CA-125": "28 U/mL",
-            "CA19-9": "29 U/mL",
-            "BP": "127/61 mmHg",
-            "MCV": "96.2 fL",
-            "MCH": "26.3 pg",
-            "MCHC": "33.8 g/dL",
-            "PT": "10.4 sec",
-            "aPTT": "27.8 sec",
-            "ESR": "5 mm/hr",
-            "RDW-CV": "12.5 %",
-            "Specific Gravity": "1.033",
-            "PH": "6.0",
-            "Protein (Dipstick)": "-",
-            "Glucose (Dipstick)": "-",
-            "Bilirubin (Dipstick)": "-",
-            "Urobilinogen (Dipstick)": "0.4 mg/dL",
-            "RBC (Urine)": "0 /HPF",
-            "WBC (Urine)": "5 /HPF",
-            "Epithelial Cells": "5 /HPF",
-            "Casts": "2 /LPF",
-            "Ketone": "-",
-            "Crystal": "None",
-            "Bacteria": "-",
-            "Albumin (Dipstick)": "10 mg/L",
-            "Creatinine (Dipstick)": "N/A",
-            "Alb/CRE Ratio": "10",
-            "Nitrite": "-",
-            "Occult Blood": "-",
-            "WBC Esterase": "-"
+        # 結構化檢驗結果，格式與鏈碼 LabResult 相同：數值結果提供 value 與 unit，
+        # 定性結果（試紙陰性等）以 text 取代 value，判讀固定為 A
+        test_results = {
+            "Glu-AC": numeric(89, "mg/dL", 70, 99),
+            "HbA1c": numeric(4.1, "%", 4.0, 5.6),
+            "Glu-PC": numeric(124, "mg/dL", None, 140),
+            "Alb": numeric(4.5, "g/dL", 3.5, 5.2),
+            "TP": numeric(6.5, "g/dL", 6.4, 8.3),
+            "AST（GOT）": numeric(27, "U/L", None, 40),
+            "ALT（GPT）": numeric(10, "U/L", None, 41),
+            "D-Bil": numeric(0.03, "mg/dL", None, 0.3),
+            "ALP": numeric(74, "U/L", 40, 130),
+            "T-Bil": numeric(0.7, "mg/dL", 0.2, 1.2),
+            "UN": numeric(23, "mg/dL", 6, 20),
+            "CRE": numeric(1.2, "mg/dL", 0.7, 1.3),
+            "U.A": numeric(4.9, "mg/dL", 3.4, 7.0),
+            "T-CHO": numeric(164, "mg/dL", None, 200),
+            "LDL-C": numeric(128, "mg/dL", None, 130),
+            "HDL-C": numeric(54, "mg/dL", 40, None),
+            "TG": numeric(143, "mg/dL", None, 150),
+            "Hb": numeric(13.3, "g/dL", 13.0, 17.0),
+            "Hct": numeric(49.7, "%", 40.0, 50.0),
+            "PLT": numeric(286, "x10^3/uL", 150, 400),
+            "WBC": numeric(4.04, "x10^3/uL", 4.0, 10.0),
+            "RBC": numeric(4.56, "x10^6/uL", 4.5, 5.9),
+            "hsCRP": numeric(0.38, "mg/dL", None, 0.3),
+            "AFP": numeric(14, "ng/mL", None, 20),
+            "CEA": numeric(2.8, "ng/mL", None, 5.0),
+            "CA-125": numeric(28, "U/mL", None, 35),
+            "CA19-9": numeric(29, "U/mL", None, 37),
+            "SBP": numeric(127, "mmHg", 90, 130),
+            "DBP": numeric(61, "mmHg", 60, 85),
+            "MCV": numeric(96.2, "fL", 80, 100),
+            "MCH": numeric(26.3, "pg", 27, 33),
+            "MCHC": numeric(33.8, "g/dL", 32, 36),
+            "PT": numeric(10.4, "sec", 9.5, 12.5),
+            "aPTT": numeric(27.8, "sec", 25, 35),
+            "ESR": numeric(5, "mm/hr", None, 15),
+            "RDW-CV": numeric(12.5, "%", 11.5, 14.5),
+            "Specific Gravity": numeric(1.033, "1", 1.005, 1.030),
+            "PH": numeric(6.0, "[pH]", 5.0, 8.0),
+            "Protein (Dipstick)": qualitative("-", "-"),
+            "Glucose (Dipstick)": qualitative("-", "-"),
+            "Bilirubin (Dipstick)": qualitative("-", "-"),
+            "Urobilinogen (Dipstick)": numeric(0.4, "mg/dL", None, 1.0),
+            "RBC (Urine)": numeric(0, "/HPF", None, 2),
+            "WBC (Urine)": numeric(5, "/HPF", None, 5),
+            "Epithelial Cells": numeric(5, "/HPF", None, 5),
+            "Casts": numeric(2, "/LPF", None, 2),
+            "Ketone": qualitative("-", "-"),
+            "Crystal": qualitative("None", "None"),
+            "Bacteria": qualitative("-", "-"),
+            "Albumin (Dipstick)": numeric(10, "mg/L", None, 30),
+            "Alb/CRE Ratio": numeric(10, "mg/g", None, 30),
+            "Nitrite": qualitative("-", "-"),
+            "Occult Blood": qualitative("-", "-"),
+            "WBC Esterase": qualitative("-", "-")
         }
-        '''
+        test_results_json = json.dumps(test_results, ensure_ascii=False)
 
         logger.info(f"\n=== 處理報告 ID: {report_id} ===")
 
@@ -139,6 +139,21 @@ class HealthAnalysisServicer(data_pb2_grpc.HealthServiceServicer):
         logger.info(f"HyDE 假設性文件：{hypothetical_doc}")
         return hypothetical_doc.strip()
 
+    def format_result(self, value):
+        # 結構化檢驗結果轉為 "89 mg/dL（參考範圍 70-99，判讀 N）"，舊格式字串原樣保留
+        if not isinstance(value, dict):
+            return str(value)
+        if "text" in value:
+            result = str(value["text"])
+        else:
+            result = f"{value.get('value', '')} {value.get('unit', '')}".strip()
+        rr = value.get("referenceRange") or {}
+        if "text" in rr:
+            range_text = str(rr["text"])
+        else:
+            range_text = f"{rr.get('low', '')}-{rr.get('high', '')}"
+        return f"{result}（參考範圍 {range_text}，判讀 {value.get('flag', '')}）"
+
     def get_multi_query_context(self, test_results):
         query_categories = {
             "blood_sugar": ["Glu-AC", "HbA1c", "Glu-PC"],
@@ -156,7 +171,7 @@ class HealthAnalysisServicer(data_pb2_grpc.HealthServiceServicer):
             "general": "血液常規正常範圍：血紅蛋白 12-16 g/dL，白細胞 4-10 x10^3/uL，血小板 150-450 x10^3/uL，高敏感C反應蛋白 < 1 mg/dL。"
         }
         for category, keys in query_categories.items():
-            category_query = "\n".join([f"{k}: {v}" for k, v in test_results.items() if k in keys])
+            category_query = "\n".join([f"{k}: {self.format_result(v)}" for k, v in test_results.items() if k in keys])
             if category_query:
                 logger.info(f"Multi-Query 子查詢 ({category})：{category_query}")
                 docs_with_scores = self.vectorstore.similarity_search_with_score(category_query, k=3)
@@ -173,7 +188,7 @@ class HealthAnalysisServicer(data_pb2_grpc.HealthServiceServicer):
         logger.info(f"👤 用戶健康報告分析：報告 ID {request.report_id}")
         try:
             test_results = json.loads(request.test_results_json)
-            query_text = "\n".join([f"{k}: {v}" for k, v in test_results.items()])
+            query_text = "\n".join([f"{k}: {self.format_result(v)}" for k, v in test_results.items()])
 
             multi_query_context = self.get_multi_query_context(test_results)
             logger.info(f"Multi-Query 檢索結果：{multi_query_context}")
@@ -257,7 +272,7 @@ class HealthAnalysisServicer(data_pb2_grpc.HealthServiceServicer):
         logger.info(f"🏢 保險公司健康報告分析：報告 ID {request.report_id}")
         try:
             test_results = json.loads(request.test_results_json)
-            query_text = "\n".join([f"{k}: {v}" for k, v in test_results.items()])
+            query_text = "\n".join([f"{k}: {self.format_result(v)}" for k, v in test_results.items()])
 
             multi_query_context = self.get_multi_query_context(test_results)
             logger.info(f"Multi-Query 檢索結果：{multi_query_context}")
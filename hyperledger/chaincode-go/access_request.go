@@ -0,0 +1,1459 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+type AuthTicket struct {
+	DocType        string      `json:"docType"`
+	PatientHash    string      `json:"patientHash"`
+	TargetHash     string      `json:"targetHash"`
+	ReportID       string      `json:"reportId"`
+	GrantedAt      int64       `json:"grantedAt"`
+	Expiry         int64       `json:"expiry"`
+	Revoked        bool        `json:"revoked,omitempty"`
+	RevokedAt      int64       `json:"revokedAt,omitempty"`
+	ExtendedAt     int64       `json:"extendedAt,omitempty"` // 最近一次核准延長的時間，延長前的期限保留於 key history
+	MaxReads       int         `json:"maxReads,omitempty"`   // 可讀取次數上限，0 代表不限制
+	ReadCount      int         `json:"readCount,omitempty"`
+	LastReadAt     int64       `json:"lastReadAt,omitempty"`
+	Exhausted      bool        `json:"exhausted,omitempty"` // 已達讀取次數上限，不再視為有效票據
+	PreviousExpiry int64       `json:"previousExpiry,omitempty"`
+	GrantedBy      string      `json:"grantedBy,omitempty"` // 實際核准者假名，代理人代為核准時與 patientHash 不同
+	RevokedBy      string      `json:"revokedBy,omitempty"`
+	PolicyID       string      `json:"policyId,omitempty"`      // 依同意政策自動核發時記錄政策ID
+	GrantedFields  []string    `json:"grantedFields,omitempty"` // 空值代表授權完整報告
+	AccessMode     string      `json:"accessMode,omitempty"`
+	Predicates     []Predicate `json:"predicates,omitempty"`
+	WriterMSP      string      `json:"writerMsp,omitempty"`
+}
+
+type AccessRequest struct {
+	DocType          string   `json:"docType"`
+	RequestID        string   `json:"requestId"`
+	RequestType      string   `json:"requestType,omitempty"` // 空值為新的授權，EXTEND 為延長既有票據
+	ReportID         string   `json:"reportId"`
+	PatientHash      string   `json:"patientHash"`
+	RequesterHash    string   `json:"requesterHash"`
+	RequesterCompany string   `json:"requesterCompany,omitempty"` // 取自鏈上保險業者登錄資料
+	RequesterLicense string   `json:"requesterLicense,omitempty"`
+	Reason           string   `json:"reason"`
+	RequestedAt      int64    `json:"requestedAt"`
+	Expiry           int64    `json:"expiry"`
+	Status           string   `json:"status"`
+	RequestedFields  []string `json:"requestedFields,omitempty"` // 空值代表請求完整報告
+	// 核准的條件，可能比請求的條件更嚴格（Expiry 與 RequestedFields 為保險業者請求的條件）
+	GrantedFields    []string    `json:"grantedFields,omitempty"`
+	GrantedExpiry    int64       `json:"grantedExpiry,omitempty"`
+	GrantedMaxReads  int         `json:"grantedMaxReads,omitempty"`
+	ResponseDeadline int64       `json:"responseDeadline,omitempty"` // 病患回應期限，逾期改為 EXPIRED（與票據 Expiry 無關）
+	AccessMode       string      `json:"accessMode,omitempty"`
+	Predicates       []Predicate `json:"predicates,omitempty"`
+	DecidedBy        string      `json:"decidedBy,omitempty"` // 實際核准或拒絕者假名，代理人代為處理時與 patientHash 不同
+	DecidedAt        int64       `json:"decidedAt,omitempty"` // 核准、拒絕或逾期的時間
+	PolicyID         string      `json:"policyId,omitempty"`  // 依同意政策自動核准時記錄政策ID
+	WriterMSP        string      `json:"writerMsp,omitempty"`
+}
+
+// 病患封鎖的保險業者，以 patientHash + requesterHash 為 key；解除封鎖時保留紀錄
+type RequesterBlock struct {
+	DocType       string `json:"docType"`
+	PatientHash   string `json:"patientHash"`
+	RequesterHash string `json:"requesterHash"`
+	Reason        string `json:"reason,omitempty"`
+	BlockedAt     int64  `json:"blockedAt"`
+	Unblocked     bool   `json:"unblocked,omitempty"`
+	UnblockedAt   int64  `json:"unblockedAt,omitempty"`
+	BlockedBy     string `json:"blockedBy,omitempty"` // 實際操作者假名，代理人代為操作時與 patientHash 不同
+	UnblockedBy   string `json:"unblockedBy,omitempty"`
+	WriterMSP     string `json:"writerMsp,omitempty"`
+}
+
+// 同一保險業者對同一病患的待處理請求與最近一次被拒絕的時間，以 patientHash + requesterHash 為 key；
+// 建立、核准、拒絕與逾期時更新，取代以 rich query 計數
+type RequestLimitState struct {
+	DocType        string           `json:"docType"`
+	PatientHash    string           `json:"patientHash"`
+	RequesterHash  string           `json:"requesterHash"`
+	Pending        []PendingRequest `json:"pending,omitempty"`
+	LastRejectedAt int64            `json:"lastRejectedAt,omitempty"`
+	UpdatedAt      int64            `json:"updatedAt"`
+	WriterMSP      string           `json:"writerMsp,omitempty"`
+}
+
+type PendingRequest struct {
+	RequestID        string `json:"requestId"`
+	ResponseDeadline int64  `json:"responseDeadline"`
+}
+
+// 檢驗項目的條件，例如 {"field":"HbA1c","op":"lt","value":6.5}
+type Predicate struct {
+	Field     string  `json:"field"`
+	Op        string  `json:"op"` // lt / lte / gt / gte / eq / ne
+	Value     float64 `json:"value"`
+	Component int     `json:"component,omitempty"` // 以 "/" 分隔的數值索引，例如 BP 的 0=收縮壓、1=舒張壓
+}
+
+// 單一條件的評估結果，不含原始數值
+type PredicateResult struct {
+	Field     string  `json:"field"`
+	Op        string  `json:"op"`
+	Value     float64 `json:"value"`
+	Component int     `json:"component,omitempty"`
+	Satisfied bool    `json:"satisfied"`
+	Evaluable bool    `json:"evaluable"` // 報告中缺少該欄位或無法解析數值時為 false
+}
+
+type PredicateAttestation struct {
+	ReportID    string            `json:"reportId"`
+	ClinicID    string            `json:"clinicId"`
+	Version     int               `json:"version"`
+	EvaluatedAt int64             `json:"evaluatedAt"`
+	Results     []PredicateResult `json:"results"`
+}
+
+type AuthTicketPage struct {
+	Records      []AuthTicket `json:"records"`
+	Bookmark     string       `json:"bookmark"`
+	FetchedCount int32        `json:"fetchedCount"`
+}
+
+type AccessRequestPage struct {
+	Records      []AccessRequest `json:"records"`
+	Bookmark     string          `json:"bookmark"`
+	FetchedCount int32           `json:"fetchedCount"`
+}
+
+// 病患的授權請求歷史，附上對應票據目前的狀態
+type AccessRequestHistoryEntry struct {
+	Request      AccessRequest `json:"request"`
+	TicketStatus string        `json:"ticketStatus,omitempty"` // ACTIVE / EXPIRED / REVOKED，未核發票據時為空值
+	TicketExpiry int64         `json:"ticketExpiry,omitempty"`
+}
+
+type AccessRequestHistoryPage struct {
+	Records      []AccessRequestHistoryEntry `json:"records"`
+	Bookmark     string                      `json:"bookmark"`
+	FetchedCount int32                       `json:"fetchedCount"`
+}
+
+type AuthorizedReportPage struct {
+	Records      []map[string]interface{} `json:"records"`
+	Bookmark     string                   `json:"bookmark"`
+	FetchedCount int32                    `json:"fetchedCount"`
+}
+
+// 整理欄位清單(internal function)：去除空白與重複
+func normalizeFields(fields []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, f := range fields {
+		f = strings.TrimSpace(f)
+		if f == "" || seen[f] {
+			continue
+		}
+		seen[f] = true
+		out = append(out, f)
+	}
+	return out
+}
+
+// 檢查條件格式(internal function)
+func validatePredicates(predicates []Predicate) error {
+	if len(predicates) == 0 {
+		return fmt.Errorf("at least one predicate is required")
+	}
+	for _, p := range predicates {
+		if strings.TrimSpace(p.Field) == "" {
+			return fmt.Errorf("predicate field is required")
+		}
+		switch p.Op {
+		case "lt", "lte", "gt", "gte", "eq", "ne":
+		default:
+			return fmt.Errorf("unsupported predicate operator %s", p.Op)
+		}
+		if p.Component < 0 {
+			return fmt.Errorf("invalid predicate component for field %s", p.Field)
+		}
+	}
+	return nil
+}
+
+// 從檢驗值取出數值(internal function)，支援結構化檢驗結果、"89 mg/dL"、"127/61 mmHg" 及 JSON 數字
+func analyteValue(raw json.RawMessage, component int) (float64, bool) {
+	var num float64
+	if json.Unmarshal(raw, &num) == nil {
+		return num, component == 0
+	}
+	var lab LabResult
+	if json.Unmarshal(raw, &lab) == nil && lab.Value != nil {
+		return *lab.Value, component == 0
+	}
+	var str string
+	if json.Unmarshal(raw, &str) != nil {
+		return 0, false
+	}
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	parts := strings.Split(fields[0], "/")
+	if component >= len(parts) {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(parts[component], 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// 對報告內容評估條件(internal function)
+func evaluatePredicates(resultJSON string, predicates []Predicate) ([]PredicateResult, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(resultJSON), &all); err != nil {
+		return nil, fmt.Errorf("report content is not a JSON object, cannot evaluate predicates")
+	}
+	keys := analyteKeys(all)
+	results := make([]PredicateResult, 0, len(predicates))
+	for _, p := range predicates {
+		r := PredicateResult{Field: p.Field, Op: p.Op, Value: p.Value, Component: p.Component}
+		if k, ok := keys[p.Field]; ok {
+			raw := all[k]
+			if v, ok := analyteValue(raw, p.Component); ok {
+				r.Evaluable = true
+				switch p.Op {
+				case "lt":
+					r.Satisfied = v < p.Value
+				case "lte":
+					r.Satisfied = v <= p.Value
+				case "gt":
+					r.Satisfied = v > p.Value
+				case "gte":
+					r.Satisfied = v >= p.Value
+				case "eq":
+					r.Satisfied = v == p.Value
+				case "ne":
+					r.Satisfied = v != p.Value
+				}
+			}
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// 寫入授權票據(internal function)，病患核准與同意政策自動核發共用
+func putTicket(ctx contractapi.TransactionContextInterface, tk AuthTicket) error {
+	ticketKey, _ := ctx.GetStub().CreateCompositeKey(keyAuthNS, []string{tk.PatientHash, tk.TargetHash, tk.ReportID})
+	tbytes, _ := json.Marshal(tk)
+	if err := ctx.GetStub().PutState(ticketKey, tbytes); err != nil {
+		return fmt.Errorf("failed to store auth ticket")
+	}
+	return nil
+}
+
+// 讀取授權票據(internal function)
+func getTicket(ctx contractapi.TransactionContextInterface, patientHash, targetHash, reportID string) (*AuthTicket, error) {
+	ticketKey, _ := ctx.GetStub().CreateCompositeKey(keyAuthNS, []string{patientHash, targetHash, reportID})
+	tbytes, err := ctx.GetStub().GetState(ticketKey)
+	if err != nil || tbytes == nil {
+		return nil, fmt.Errorf("ticket not found")
+	}
+	var tk AuthTicket
+	if err := json.Unmarshal(tbytes, &tk); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ticket: %v", err)
+	}
+	return &tk, nil
+}
+
+// 核准延長請求(internal function)：直接更新既有票據的期限，延長前的票據內容保留於 key history
+func approveExtension(ctx contractapi.TransactionContextInterface, reqKey string, req AccessRequest, actorHash string, grantedFields []string, grantedExpiry int64) error {
+	if len(normalizeFields(grantedFields)) > 0 {
+		return fmt.Errorf("field restriction does not apply to extension requests")
+	}
+	tk, err := getTicket(ctx, req.PatientHash, req.RequesterHash, req.ReportID)
+	if err != nil {
+		return err
+	}
+	if tk.Revoked {
+		return fmt.Errorf("ticket already revoked")
+	}
+
+	// 病患可縮短延長後的期限，但仍須晚於目前期限且不超過請求的期限
+	expiry := req.Expiry
+	if grantedExpiry != 0 {
+		if grantedExpiry <= txTime(ctx) || grantedExpiry <= tk.Expiry || grantedExpiry > req.Expiry {
+			return fmt.Errorf("granted expiry must be later than the current expiry and not later than requested")
+		}
+		expiry = grantedExpiry
+	}
+
+	req.Status = "APPROVED"
+	req.DecidedAt = txTime(ctx)
+	req.GrantedFields = tk.GrantedFields
+	req.GrantedExpiry = expiry
+	req.DecidedBy = actorHash
+	req.WriterMSP = getMSPID(ctx)
+	newReqBytes, _ := json.Marshal(req)
+	if err := ctx.GetStub().PutState(reqKey, newReqBytes); err != nil {
+		return fmt.Errorf("failed to update request status")
+	}
+	if err := releasePendingRequests(ctx, []AccessRequest{req}, false); err != nil {
+		return err
+	}
+
+	previous := tk.Expiry
+	tk.PreviousExpiry = previous
+	tk.Expiry = expiry
+	tk.ExtendedAt = txTime(ctx)
+	tk.GrantedBy = actorHash
+	tk.WriterMSP = getMSPID(ctx)
+	if err := putTicket(ctx, *tk); err != nil {
+		return err
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"requestId":       req.RequestID,
+		"requestType":     req.RequestType,
+		"reportId":        req.ReportID,
+		"patientHash":     req.PatientHash,
+		"requesterHash":   req.RequesterHash,
+		"status":          "APPROVED",
+		"extendedAt":      tk.ExtendedAt,
+		"expiry":          expiry,
+		"previousExpiry":  previous,
+		"requestedExpiry": req.Expiry,
+		"actorHash":       actorHash,
+	})
+	if err := ctx.GetStub().SetEvent("AccessApproved", eventPayload); err != nil {
+		return fmt.Errorf("failed to set event")
+	}
+	return nil
+}
+
+// 授權請求的回應期限(internal function)，舊資料沒有期限時以請求時間加上目前設定計算
+func responseDeadline(req AccessRequest, cfg *SystemConfig) int64 {
+	if req.ResponseDeadline > 0 {
+		return req.ResponseDeadline
+	}
+	return req.RequestedAt + cfg.RequestResponseWindow
+}
+
+// 依票據狀態產生 selector 條件(internal function)：ACTIVE / EXPIRED / REVOKED
+func ticketStatusSelector(selector map[string]interface{}, status string, now int64) error {
+	switch status {
+	case "":
+	case "ACTIVE":
+		selector["revoked"] = map[string]interface{}{"$exists": false}
+		selector["exhausted"] = map[string]interface{}{"$exists": false}
+		selector["expiry"] = map[string]interface{}{"$gte": now}
+	case "EXHAUSTED":
+		selector["revoked"] = map[string]interface{}{"$exists": false}
+		selector["exhausted"] = true
+		selector["expiry"] = map[string]interface{}{"$gte": now}
+	case "EXPIRED":
+		selector["revoked"] = map[string]interface{}{"$exists": false}
+		selector["expiry"] = map[string]interface{}{"$lt": now}
+	case "REVOKED":
+		selector["revoked"] = true
+	default:
+		return fmt.Errorf("unsupported ticket status %s", status)
+	}
+	return nil
+}
+
+// 分頁查詢授權請求(internal function)
+func queryAccessRequestPage(ctx contractapi.TransactionContextInterface, selector map[string]interface{}, pageSize int32, bookmark string, filter ListFilter) (*AccessRequestPage, error) {
+	selector["docType"] = docAccessRequest
+	if r := dateRange(filter); r != nil {
+		selector["requestedAt"] = r
+	}
+	if _, fixed := selector["status"]; !fixed && filter.Status != "" {
+		selector["status"] = filter.Status
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &AccessRequestPage{Records: []AccessRequest{}, Bookmark: next, FetchedCount: fetched}
+	insurers := map[string]*Insurer{}
+	for _, v := range values {
+		var req AccessRequest
+		if err := json.Unmarshal(v, &req); err != nil {
+			continue
+		}
+		// 登錄制度上線前的舊請求沒有公司資料，改以目前的登錄資料補上
+		if req.RequesterCompany == "" {
+			insurer, ok := insurers[req.RequesterHash]
+			if !ok {
+				_, insurer, _ = getInsurer(ctx, req.RequesterHash)
+				insurers[req.RequesterHash] = insurer
+			}
+			if insurer != nil {
+				req.RequesterCompany = insurer.LegalName
+				req.RequesterLicense = insurer.LicenseNumber
+			}
+		}
+		page.Records = append(page.Records, req)
+	}
+	return page, nil
+}
+
+// 查詢有效的授權票據(internal function)，已撤銷、過期或已達讀取次數上限皆視為無效
+func findValidTicket(ctx contractapi.TransactionContextInterface, patientHash, targetHash, reportID string) (*AuthTicket, error) {
+	tk, err := findIssuedTicket(ctx, patientHash, targetHash, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if readLimitReached(*tk) {
+		return nil, fmt.Errorf("read limit reached for report %s", reportID)
+	}
+	return tk, nil
+}
+
+// 票據設有讀取次數上限且已用完(internal function)
+func readLimitReached(tk AuthTicket) bool {
+	return tk.MaxReads > 0 && tk.ReadCount >= tk.MaxReads
+}
+
+// 查詢未撤銷且未過期的授權票據(internal function)，不檢查讀取次數
+func findIssuedTicket(ctx contractapi.TransactionContextInterface, patientHash, targetHash, reportID string) (*AuthTicket, error) {
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyAuthNS, []string{patientHash, targetHash, reportID})
+	if err != nil {
+		return nil, fmt.Errorf("error accessing authorization ticket")
+	}
+	defer iter.Close()
+
+	expired := false
+	revoked := false
+	now := txTime(ctx)
+	for iter.HasNext() {
+		kv, _ := iter.Next()
+		var tk AuthTicket
+		if err := json.Unmarshal(kv.Value, &tk); err != nil {
+			continue
+		}
+		if tk.ReportID != reportID {
+			continue
+		}
+		if tk.Revoked {
+			revoked = true
+		} else if now <= tk.Expiry {
+			return &tk, nil
+		} else {
+			expired = true
+		}
+	}
+	if revoked {
+		return nil, fmt.Errorf("access revoked for report %s", reportID)
+	}
+	if expired {
+		return nil, fmt.Errorf("access expired for report %s", reportID)
+	}
+	return nil, fmt.Errorf("access denied for report %s", reportID)
+}
+
+// ApproveAndAuthorizeAccess 病患核准授權請求並核發票據；grantedFields 與 grantedExpiry 可縮小請求的欄位與期限，
+// 空值與 0 代表沿用請求的條件；maxReads 限制報告內容的讀取次數，0 代表不限制
+func (h *HealthCheckContract) ApproveAndAuthorizeAccess(ctx contractapi.TransactionContextInterface, requestID string, grantedFields []string, grantedExpiry int64, maxReads int32) error {
+	// 1. 取得請求並驗證
+	reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{requestID})
+	reqBytes, err := ctx.GetStub().GetState(reqKey)
+	if err != nil || reqBytes == nil {
+		return fmt.Errorf("request not found")
+	}
+	var req AccessRequest
+	if err := json.Unmarshal(reqBytes, &req); err != nil {
+		return fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	// 病患本人或持有 APPROVE 代理授權者
+	_, actorHash, err := actingPatient(ctx, req.PatientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	if req.Status != "PENDING" {
+		return fmt.Errorf("request already handled")
+	}
+	_, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if txTime(ctx) > responseDeadline(req, cfg) {
+		return fmt.Errorf("request response deadline has passed")
+	}
+	if maxReads < 0 {
+		return fmt.Errorf("max reads must not be negative")
+	}
+	if req.RequestType == requestTypeExtend {
+		// 延長沿用原票據的讀取次數上限與已讀次數
+		if maxReads != 0 {
+			return fmt.Errorf("read limit does not apply to extension requests")
+		}
+		return approveExtension(ctx, reqKey, req, actorHash, grantedFields, grantedExpiry)
+	}
+	if req.AccessMode == accessModePredicate && maxReads != 0 {
+		return fmt.Errorf("read limit does not apply to predicate requests")
+	}
+
+	// 病患可只核准部分請求欄位；未指定時沿用請求的欄位
+	granted := normalizeFields(grantedFields)
+	if req.AccessMode == accessModePredicate && len(granted) > 0 {
+		return fmt.Errorf("field restriction does not apply to predicate requests")
+	}
+	if len(granted) == 0 {
+		granted = req.RequestedFields
+	} else if len(req.RequestedFields) > 0 {
+		requested := make(map[string]bool)
+		for _, f := range req.RequestedFields {
+			requested[f] = true
+		}
+		for _, f := range granted {
+			if !requested[f] {
+				return fmt.Errorf("field %s was not requested", f)
+			}
+		}
+	}
+
+	// 病患可縮短授權期限，但不可超過請求的期限
+	expiry := req.Expiry
+	if grantedExpiry != 0 {
+		if grantedExpiry <= txTime(ctx) || grantedExpiry > req.Expiry {
+			return fmt.Errorf("granted expiry must be in the future and not later than requested")
+		}
+		expiry = grantedExpiry
+	}
+
+	// 2. 更新狀態並記錄核准的條件
+	req.Status = "APPROVED"
+	req.DecidedAt = txTime(ctx)
+	req.GrantedFields = granted
+	req.GrantedExpiry = expiry
+	req.GrantedMaxReads = int(maxReads)
+	req.DecidedBy = actorHash
+	req.WriterMSP = getMSPID(ctx)
+	newReqBytes, _ := json.Marshal(req)
+	if err := ctx.GetStub().PutState(reqKey, newReqBytes); err != nil {
+		return fmt.Errorf("failed to update request status")
+	}
+	if err := releasePendingRequests(ctx, []AccessRequest{req}, false); err != nil {
+		return err
+	}
+
+	// 3. 產生授權票據
+	tk := AuthTicket{
+		DocType:       docAuth,
+		PatientHash:   req.PatientHash,
+		TargetHash:    req.RequesterHash,
+		ReportID:      req.ReportID,
+		GrantedAt:     txTime(ctx),
+		Expiry:        expiry,
+		GrantedFields: granted,
+		MaxReads:      int(maxReads),
+		AccessMode:    req.AccessMode,
+		Predicates:    req.Predicates,
+		GrantedBy:     actorHash,
+		WriterMSP:     getMSPID(ctx),
+	}
+	if err := putTicket(ctx, tk); err != nil {
+		return err
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"requestId":       req.RequestID,
+		"reportId":        req.ReportID,
+		"patientHash":     req.PatientHash,
+		"requesterHash":   req.RequesterHash,
+		"requestType":     req.RequestType,
+		"status":          "APPROVED",
+		"grantedAt":       txTime(ctx),
+		"expiry":          expiry,
+		"grantedFields":   granted,
+		"requestedExpiry": req.Expiry,
+		"requestedFields": req.RequestedFields,
+		"maxReads":        maxReads,
+		"accessMode":      req.AccessMode,
+		"actorHash":       actorHash,
+	})
+	if err := ctx.GetStub().SetEvent("AccessApproved", eventPayload); err != nil {
+		return fmt.Errorf("failed to set event")
+	}
+
+	return nil
+}
+
+// RejectAccessRequest 病患拒絕授權請求
+func (h *HealthCheckContract) RejectAccessRequest(ctx contractapi.TransactionContextInterface, requestID string) error {
+	// 1. 取得請求並驗證
+	reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{requestID})
+	reqBytes, err := ctx.GetStub().GetState(reqKey)
+	if err != nil || reqBytes == nil {
+		return fmt.Errorf("request not found")
+	}
+
+	var req AccessRequest
+	if err := json.Unmarshal(reqBytes, &req); err != nil {
+		return fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+
+	_, actorHash, err := actingPatient(ctx, req.PatientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+
+	if req.Status != "PENDING" {
+		return fmt.Errorf("request already handled")
+	}
+	_, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if txTime(ctx) > responseDeadline(req, cfg) {
+		return fmt.Errorf("request response deadline has passed")
+	}
+
+	// 2. 更新狀態為拒絕
+	req.Status = "REJECTED"
+	req.DecidedAt = txTime(ctx)
+	req.DecidedBy = actorHash
+	req.WriterMSP = getMSPID(ctx)
+	newReqBytes, _ := json.Marshal(req)
+	if err := ctx.GetStub().PutState(reqKey, newReqBytes); err != nil {
+		return fmt.Errorf("failed to update request status")
+	}
+	// 拒絕後開始冷卻期間
+	if err := releasePendingRequests(ctx, []AccessRequest{req}, true); err != nil {
+		return err
+	}
+
+	// 3. 發送拒絕事件
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"requestId":     req.RequestID,
+		"reportId":      req.ReportID,
+		"patientHash":   req.PatientHash,
+		"requesterHash": req.RequesterHash,
+		"status":        "REJECTED",
+		"rejectedAt":    txTime(ctx),
+		"actorHash":     actorHash,
+	})
+	if err := ctx.GetStub().SetEvent("AccessRejected", eventPayload); err != nil {
+		return fmt.Errorf("failed to set event")
+	}
+
+	return nil
+}
+
+// RevokeAccess 病患（或持有 APPROVE 代理授權者）在到期前撤銷已核發的授權票據，patientHash 為空代表呼叫者本人
+func (h *HealthCheckContract) RevokeAccess(ctx contractapi.TransactionContextInterface, targetHash, reportID, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+
+	// 1. 取得授權票據
+	ticketKey, _ := ctx.GetStub().CreateCompositeKey(keyAuthNS, []string{patientHash, targetHash, reportID})
+	tbytes, err := ctx.GetStub().GetState(ticketKey)
+	if err != nil || tbytes == nil {
+		return fmt.Errorf("ticket not found")
+	}
+	var tk AuthTicket
+	if err := json.Unmarshal(tbytes, &tk); err != nil {
+		return fmt.Errorf("failed to unmarshal ticket: %v", err)
+	}
+	if tk.Revoked {
+		return fmt.Errorf("ticket already revoked")
+	}
+
+	// 2. 標記撤銷（保留票據以利稽核）
+	tk.Revoked = true
+	tk.RevokedAt = txTime(ctx)
+	tk.RevokedBy = actorHash
+	tk.WriterMSP = getMSPID(ctx)
+	newTicketBytes, _ := json.Marshal(tk)
+	if err := ctx.GetStub().PutState(ticketKey, newTicketBytes); err != nil {
+		return fmt.Errorf("failed to update auth ticket")
+	}
+
+	// 3. 發送撤銷事件
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"reportId":      tk.ReportID,
+		"patientHash":   tk.PatientHash,
+		"requesterHash": tk.TargetHash,
+		"status":        "REVOKED",
+		"revokedAt":     tk.RevokedAt,
+		"actorHash":     actorHash,
+	})
+	if err := ctx.GetStub().SetEvent("AccessRevoked", eventPayload); err != nil {
+		return fmt.Errorf("failed to set event")
+	}
+
+	return nil
+}
+
+// 病患查詢自己核發的授權票據（分頁），filter.Status 可為 ACTIVE / EXHAUSTED / EXPIRED / REVOKED
+func (h *HealthCheckContract) ListMyAuthorizedTickets(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*AuthTicketPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeApprove)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"docType":     docAuth,
+		"patientHash": patientHash,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["grantedAt"] = r
+	}
+	if err := ticketStatusSelector(selector, filter.Status, txTime(ctx)); err != nil {
+		return nil, err
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &AuthTicketPage{Records: []AuthTicket{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var tk AuthTicket
+		if err := json.Unmarshal(v, &tk); err != nil {
+			continue
+		}
+		page.Records = append(page.Records, tk)
+	}
+	return page, nil
+}
+
+// 保險業者查看所有已授權的報告
+func (h *HealthCheckContract) ListAuthorizedReports(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*AuthorizedReportPage, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+	if role != "insurer" {
+		return nil, fmt.Errorf("only insurer can list authorized reports")
+	}
+
+	// 只查詢未撤銷且未過期的票據，分頁筆數才會與回傳結果一致
+	now := txTime(ctx)
+	selector := map[string]interface{}{
+		"docType":    docAuth,
+		"targetHash": callerHash(ctx, userID),
+	}
+	if r := dateRange(filter); r != nil {
+		selector["grantedAt"] = r
+	}
+	if err := ticketStatusSelector(selector, "ACTIVE", now); err != nil {
+		return nil, err
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query authorization tickets: %v", err)
+	}
+	page := &AuthorizedReportPage{Records: []map[string]interface{}{}, Bookmark: next, FetchedCount: fetched}
+
+	for _, v := range values {
+		var tk AuthTicket
+		if err := json.Unmarshal(v, &tk); err != nil {
+			continue
+		}
+		if now > tk.Expiry || tk.Revoked {
+			continue // 忽略過期或已撤銷的授權
+		}
+
+		// 查詢對應報告
+		repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{tk.ReportID})
+		rb, err := ctx.GetStub().GetState(repKey)
+		if err != nil || rb == nil {
+			continue
+		}
+
+		var rep HealthReport
+		if err := json.Unmarshal(rb, &rep); err != nil {
+			continue
+		}
+		// 健檢中心篩選需讀取報告後才能判斷，該頁筆數可能少於 pageSize
+		if filter.ClinicID != "" && rep.ClinicID != filter.ClinicID {
+			continue
+		}
+
+		// 組合報告和授權信息
+		result := map[string]interface{}{
+			"reportId":    rep.ReportID,
+			"clinicId":    rep.ClinicID,
+			"patientHash": rep.PatientHash,
+			"createdAt":   rep.CreatedAt,
+			"version":     reportVersion(rep),
+			"expiry":      tk.Expiry,
+		}
+		if tk.AccessMode == accessModePredicate {
+			// 條件授權不回傳報告內容，只能透過 EvaluateAuthorizedPredicates 取得布林結果
+			result["accessMode"] = tk.AccessMode
+			result["predicates"] = tk.Predicates
+		} else {
+			// 報告內容須經 RecordReportRead 寫入讀取收據後以 ReadAuthorizedReport 取得
+			if len(tk.GrantedFields) > 0 {
+				result["grantedFields"] = tk.GrantedFields
+			}
+			result["readCount"] = tk.ReadCount
+			if tk.MaxReads > 0 {
+				result["maxReads"] = tk.MaxReads
+			}
+		}
+		page.Records = append(page.Records, result)
+	}
+
+	return page, nil
+}
+
+// 讀取封鎖紀錄(internal function)
+func getRequesterBlock(ctx contractapi.TransactionContextInterface, patientHash, requesterHash string) (string, *RequesterBlock, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyRequesterBlockNS, []string{patientHash, requesterHash})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read requester block: %v", err)
+	}
+	if data == nil {
+		return key, nil, nil
+	}
+	var b RequesterBlock
+	if err := json.Unmarshal(data, &b); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal requester block: %v", err)
+	}
+	return key, &b, nil
+}
+
+// 讀取病患與保險業者之間的請求限制狀態(internal function)，不存在時回傳空狀態
+func getRequestLimitState(ctx contractapi.TransactionContextInterface, patientHash, requesterHash string) (string, *RequestLimitState, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(keyRequestLimitNS, []string{patientHash, requesterHash})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create request limit key: %v", err)
+	}
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read request limit state: %v", err)
+	}
+	st := RequestLimitState{DocType: docRequestLimit, PatientHash: patientHash, RequesterHash: requesterHash}
+	if data != nil {
+		if err := json.Unmarshal(data, &st); err != nil {
+			return "", nil, fmt.Errorf("failed to unmarshal request limit state: %v", err)
+		}
+	}
+	return key, &st, nil
+}
+
+func putRequestLimitState(ctx contractapi.TransactionContextInterface, key string, st *RequestLimitState) error {
+	st.UpdatedAt = txTime(ctx)
+	st.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(st)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store request limit state")
+	}
+	return nil
+}
+
+// 移除已過回應期限的待處理請求(internal function)：尚未被排程標記為 EXPIRED 的請求也不再計入
+func (st *RequestLimitState) prune(now int64) {
+	kept := st.Pending[:0]
+	for _, p := range st.Pending {
+		if now <= p.ResponseDeadline {
+			kept = append(kept, p)
+		}
+	}
+	st.Pending = kept
+}
+
+func (st *RequestLimitState) remove(requestID string) {
+	for i, p := range st.Pending {
+		if p.RequestID == requestID {
+			st.Pending = append(st.Pending[:i], st.Pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// 檢查封鎖、待處理請求上限與拒絕後冷卻期間(internal function)；延長請求不受冷卻期間限制
+func checkRequestLimits(ctx contractapi.TransactionContextInterface, cfg *SystemConfig, patientHash, requesterHash, requestType string) error {
+	_, b, err := getRequesterBlock(ctx, patientHash, requesterHash)
+	if err != nil {
+		return err
+	}
+	if b != nil && !b.Unblocked {
+		return fmt.Errorf("requester is blocked by patient")
+	}
+
+	_, st, err := getRequestLimitState(ctx, patientHash, requesterHash)
+	if err != nil {
+		return err
+	}
+	now := txTime(ctx)
+	if requestType != requestTypeExtend && st.LastRejectedAt > 0 && now < st.LastRejectedAt+cfg.RejectionCooldown {
+		return fmt.Errorf("request rejected recently, cooldown until %d", st.LastRejectedAt+cfg.RejectionCooldown)
+	}
+	st.prune(now)
+	if int32(len(st.Pending)) >= cfg.MaxPendingRequests {
+		return fmt.Errorf("too many pending requests for this patient (limit %d)", cfg.MaxPendingRequests)
+	}
+	return nil
+}
+
+// 待處理授權請求的索引鍵(internal function)：以補零的回應期限排序，ExpireStaleAccessRequests 以複合鍵範圍查詢掃描，
+// 不使用 rich query，提交時才能重新驗證掃描範圍
+func pendingRequestKey(ctx contractapi.TransactionContextInterface, req AccessRequest) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(keyPendingRequestNS, []string{fmt.Sprintf("%019d", req.ResponseDeadline), req.RequestID})
+	if err != nil {
+		return "", fmt.Errorf("failed to create pending request key: %v", err)
+	}
+	return key, nil
+}
+
+// 新的待處理請求寫入索引並計入請求限制狀態(internal function)
+func trackPendingRequest(ctx contractapi.TransactionContextInterface, req *AccessRequest) error {
+	indexKey, err := pendingRequestKey(ctx, *req)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(indexKey, []byte{0x00}); err != nil {
+		return fmt.Errorf("failed to store pending request index")
+	}
+	key, st, err := getRequestLimitState(ctx, req.PatientHash, req.RequesterHash)
+	if err != nil {
+		return err
+	}
+	st.prune(txTime(ctx))
+	st.Pending = append(st.Pending, PendingRequest{RequestID: req.RequestID, ResponseDeadline: req.ResponseDeadline})
+	return putRequestLimitState(ctx, key, st)
+}
+
+// 已核准、拒絕或逾期的請求從待處理索引與請求限制狀態移除(internal function)，rejected 為 true 時開始冷卻期間。
+// 同一交易中讀不到自己寫入的狀態，因此同一組病患與保險業者的請求需一次處理
+func releasePendingRequests(ctx contractapi.TransactionContextInterface, reqs []AccessRequest, rejected bool) error {
+	type pair struct{ patientHash, requesterHash string }
+	var order []pair
+	grouped := make(map[pair][]string)
+	for _, req := range reqs {
+		indexKey, err := pendingRequestKey(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := ctx.GetStub().DelState(indexKey); err != nil {
+			return fmt.Errorf("failed to delete pending request index")
+		}
+		k := pair{req.PatientHash, req.RequesterHash}
+		if _, ok := grouped[k]; !ok {
+			order = append(order, k)
+		}
+		grouped[k] = append(grouped[k], req.RequestID)
+	}
+	for _, k := range order {
+		key, st, err := getRequestLimitState(ctx, k.patientHash, k.requesterHash)
+		if err != nil {
+			return err
+		}
+		for _, id := range grouped[k] {
+			st.remove(id)
+		}
+		if rejected {
+			st.LastRejectedAt = txTime(ctx)
+		}
+		st.prune(txTime(ctx))
+		if err := putRequestLimitState(ctx, key, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 建立授權請求(internal function)，RequestAccess、RequestAccessExtension 與 RequestPredicateAccess 共用
+func newAccessRequest(ctx contractapi.TransactionContextInterface, requestType, reportID, patientHash, reason, expiryStr string) (*AccessRequest, error) {
+	insurer, err := requireActiveInsurer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 檢查報告是否存在
+	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
+	rb, _ := ctx.GetStub().GetState(repKey)
+	if rb == nil {
+		return nil, fmt.Errorf("report not found")
+	}
+
+	expiry, errExp := strconv.ParseInt(expiryStr, 10, 64)
+	if errExp != nil || expiry <= txTime(ctx) {
+		return nil, fmt.Errorf("invalid expiry")
+	}
+
+	_, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRequestLimits(ctx, cfg, patientHash, insurer.InsurerHash, requestType); err != nil {
+		return nil, err
+	}
+
+	return &AccessRequest{
+		DocType:          docAccessRequest,
+		RequestID:        "req_" + ctx.GetStub().GetTxID(),
+		RequestType:      requestType,
+		ReportID:         reportID,
+		PatientHash:      patientHash,
+		RequesterHash:    insurer.InsurerHash,
+		RequesterCompany: insurer.LegalName,
+		RequesterLicense: insurer.LicenseNumber,
+		Reason:           reason,
+		RequestedAt:      txTime(ctx),
+		Expiry:           expiry,
+		Status:           "PENDING",
+		ResponseDeadline: txTime(ctx) + cfg.RequestResponseWindow,
+		WriterMSP:        getMSPID(ctx),
+	}, nil
+}
+
+// 寫入新的授權請求與報告索引(internal function)，仍待處理者計入請求限制狀態
+func putAccessRequest(ctx contractapi.TransactionContextInterface, req *AccessRequest) error {
+	reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{req.RequestID})
+	reqBytes, _ := json.Marshal(req)
+	if err := ctx.GetStub().PutState(reqKey, reqBytes); err != nil {
+		return fmt.Errorf("failed to store access request")
+	}
+	indexKey, _ := ctx.GetStub().CreateCompositeKey(keyReportRequestNS, []string{req.ReportID, req.RequestID})
+	if err := ctx.GetStub().PutState(indexKey, []byte{0x00}); err != nil {
+		return fmt.Errorf("failed to store report request index")
+	}
+	if req.Status == "PENDING" {
+		return trackPendingRequest(ctx, req)
+	}
+	return nil
+}
+
+// 請求授權，回傳以交易ID產生的 requestID；requestedFields 為空代表請求完整報告，
+// 病患有符合的同意政策時直接核准並核發票據
+func (h *HealthCheckContract) RequestAccess(ctx contractapi.TransactionContextInterface, reportID, patientHash, reason, expiryStr string, requestedFields []string) (string, error) {
+	req, err := newAccessRequest(ctx, "", reportID, patientHash, reason, expiryStr)
+	if err != nil {
+		return "", err
+	}
+	req.RequestedFields = normalizeFields(requestedFields)
+	if err := autoApprove(ctx, req); err != nil {
+		return "", err
+	}
+	if err := putAccessRequest(ctx, req); err != nil {
+		return "", err
+	}
+	return req.RequestID, nil
+}
+
+// 請求延長既有票據的期限，走與一般授權請求相同的待處理流程；欄位與條件沿用原票據，
+// 不適用同意政策自動核准，也不受拒絕後冷卻期間限制
+func (h *HealthCheckContract) RequestAccessExtension(ctx contractapi.TransactionContextInterface, reportID, patientHash, reason, expiryStr string) (string, error) {
+	req, err := newAccessRequest(ctx, requestTypeExtend, reportID, patientHash, reason, expiryStr)
+	if err != nil {
+		return "", err
+	}
+	tk, err := getTicket(ctx, patientHash, req.RequesterHash, reportID)
+	if err != nil {
+		return "", err
+	}
+	if tk.Revoked {
+		return "", fmt.Errorf("ticket already revoked")
+	}
+	if req.Expiry <= tk.Expiry {
+		return "", fmt.Errorf("requested expiry must be later than the current expiry")
+	}
+	req.RequestedFields = tk.GrantedFields
+	req.AccessMode = tk.AccessMode
+	req.Predicates = tk.Predicates
+	if err := putAccessRequest(ctx, req); err != nil {
+		return "", err
+	}
+	return req.RequestID, nil
+}
+
+// 請求條件授權：核准後保險業者只能取得條件的布林結果，無法讀取原始數值
+func (h *HealthCheckContract) RequestPredicateAccess(ctx contractapi.TransactionContextInterface, reportID, patientHash, reason, expiryStr string, predicates []Predicate) (string, error) {
+	if err := validatePredicates(predicates); err != nil {
+		return "", err
+	}
+	req, err := newAccessRequest(ctx, "", reportID, patientHash, reason, expiryStr)
+	if err != nil {
+		return "", err
+	}
+	req.AccessMode = accessModePredicate
+	req.Predicates = predicates
+	if err := autoApprove(ctx, req); err != nil {
+		return "", err
+	}
+	if err := putAccessRequest(ctx, req); err != nil {
+		return "", err
+	}
+	return req.RequestID, nil
+}
+
+/**
+ * @notice 保險業者評估已授權的條件
+ * @dev 只允許持有有效條件授權票據的 insurer，回傳布林結果而不含原始數值
+ * @param ctx Fabric合約上下文
+ * @param patientHash 病患hash
+ * @param reportID 報告ID
+ * @return PredicateAttestation 各條件的評估結果, error 查詢失敗或無權限
+ */
+func (h *HealthCheckContract) EvaluateAuthorizedPredicates(ctx contractapi.TransactionContextInterface, patientHash, reportID string) (*PredicateAttestation, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return nil, fmt.Errorf("only insurer can evaluate predicates")
+	}
+
+	tk, err := findValidTicket(ctx, patientHash, callerHash(ctx, userID), reportID)
+	if err != nil {
+		return nil, err
+	}
+	if tk.AccessMode != accessModePredicate || len(tk.Predicates) == 0 {
+		return nil, fmt.Errorf("ticket does not grant predicate evaluation")
+	}
+
+	repKey, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	resultJSON, err := readReportResult(ctx, repKey, *rep)
+	if err != nil {
+		return nil, err
+	}
+	results, err := evaluatePredicates(resultJSON, tk.Predicates)
+	if err != nil {
+		return nil, err
+	}
+	return &PredicateAttestation{
+		ReportID:    rep.ReportID,
+		ClinicID:    rep.ClinicID,
+		Version:     reportVersion(*rep),
+		EvaluatedAt: txTime(ctx),
+		Results:     results,
+	}, nil
+}
+
+// 列出待處理的授權請求
+func (h *HealthCheckContract) ListPendingAccessRequests(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*AccessRequestPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeApprove)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"patientHash": patientHash,
+		"status":      "PENDING",
+	}
+	return queryAccessRequestPage(ctx, selector, pageSize, bookmark, filter)
+}
+
+// 列出病患所有的授權請求（含已核准、拒絕、逾期），可依保險業者與請求狀態篩選；
+// 已核准的請求附上對應票據目前的狀態，票據可能已被後續的請求延長或重新核發
+func (h *HealthCheckContract) ListMyAccessRequestHistory(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*AccessRequestHistoryPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeApprove)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"patientHash": patientHash,
+	}
+	if filter.RequesterHash != "" {
+		selector["requesterHash"] = filter.RequesterHash
+	}
+	page, err := queryAccessRequestPage(ctx, selector, pageSize, bookmark, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &AccessRequestHistoryPage{Records: []AccessRequestHistoryEntry{}, Bookmark: page.Bookmark, FetchedCount: page.FetchedCount}
+	tickets := map[string]*AuthTicket{}
+	now := txTime(ctx)
+	for _, req := range page.Records {
+		entry := AccessRequestHistoryEntry{Request: req}
+		if req.Status == "APPROVED" {
+			key := req.RequesterHash + "|" + req.ReportID
+			tk, ok := tickets[key]
+			if !ok {
+				tk, _ = getTicket(ctx, req.PatientHash, req.RequesterHash, req.ReportID)
+				tickets[key] = tk
+			}
+			if tk != nil {
+				entry.TicketExpiry = tk.Expiry
+				switch {
+				case tk.Revoked:
+					entry.TicketStatus = "REVOKED"
+				case now > tk.Expiry:
+					entry.TicketStatus = "EXPIRED"
+				case readLimitReached(*tk):
+					entry.TicketStatus = "EXHAUSTED"
+				default:
+					entry.TicketStatus = "ACTIVE"
+				}
+			}
+		}
+		history.Records = append(history.Records, entry)
+	}
+	return history, nil
+}
+
+// 列出保險業者發出的授權請求
+func (h *HealthCheckContract) ListMyAccessRequests(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*AccessRequestPage, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return nil, fmt.Errorf("only insurer can list their access requests")
+	}
+
+	selector := map[string]interface{}{
+		"requesterHash": callerHash(ctx, userID),
+	}
+	return queryAccessRequestPage(ctx, selector, pageSize, bookmark, filter)
+}
+
+// 列出保險業者在 days 天內到期的有效票據，供主動請求延長
+func (h *HealthCheckContract) ListExpiringTickets(ctx contractapi.TransactionContextInterface, days int32, pageSize int32, bookmark string) (*AuthTicketPage, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return nil, fmt.Errorf("only insurer can list expiring tickets")
+	}
+	if days <= 0 {
+		return nil, fmt.Errorf("days must be positive")
+	}
+
+	now := txTime(ctx)
+	selector := map[string]interface{}{
+		"docType":    docAuth,
+		"targetHash": callerHash(ctx, userID),
+		"revoked":    map[string]interface{}{"$exists": false},
+		"exhausted":  map[string]interface{}{"$exists": false},
+		"expiry": map[string]interface{}{
+			"$gte": now,
+			"$lte": now + int64(days)*24*60*60,
+		},
+	}
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &AuthTicketPage{Records: []AuthTicket{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var tk AuthTicket
+		if err := json.Unmarshal(v, &tk); err != nil {
+			continue
+		}
+		page.Records = append(page.Records, tk)
+	}
+	return page, nil
+}
+
+// 計算報告的授權請求數與待處理數(internal function)，以 REPORT_REQUEST 索引的複合鍵範圍查詢取得請求
+func countAccessRequests(ctx contractapi.TransactionContextInterface, reportID string) (total, pending int, err error) {
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyReportRequestNS, []string{reportID})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to scan access requests: %v", err)
+	}
+	defer iter.Close()
+
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		_, parts, err := ctx.GetStub().SplitCompositeKey(kv.Key)
+		if err != nil || len(parts) != 2 {
+			continue
+		}
+		reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{parts[1]})
+		data, err := ctx.GetStub().GetState(reqKey)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read request %s: %v", parts[1], err)
+		}
+		var req struct {
+			Status string `json:"status"`
+		}
+		if data == nil || json.Unmarshal(data, &req) != nil {
+			continue
+		}
+		total++
+		if req.Status == "PENDING" {
+			pending++
+		}
+	}
+	return total, pending, nil
+}
+
+/**
+ * @notice 將逾期未回應的授權請求標記為 EXPIRED
+ * @dev 只允許 system 身份，由 go_server 排程定期呼叫；一次最多處理 limit 筆，並發出單一 AccessRequestExpired 事件。
+ *      以 PENDING_REQUEST 索引的複合鍵範圍查詢取得逾期請求，避免提交交易使用 rich query 造成幻讀
+ * @param ctx Fabric合約上下文
+ * @param limit 本次最多處理的筆數，0 使用預設分頁大小
+ * @return int 本次標記為逾期的筆數, error 權限或寫入失敗
+ */
+func (h *HealthCheckContract) ExpireStaleAccessRequests(ctx contractapi.TransactionContextInterface, limit int32) (int, error) {
+	if err := requireSystem(ctx); err != nil {
+		return 0, err
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	_, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	now := txTime(ctx)
+
+	// 待處理索引依回應期限排序，掃描到第一筆尚未逾期者即停止
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyPendingRequestNS, []string{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan pending requests: %v", err)
+	}
+	defer iter.Close()
+
+	var expired []map[string]interface{}
+	var released []AccessRequest
+	for iter.HasNext() && int32(len(expired)) < limit {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		_, parts, err := ctx.GetStub().SplitCompositeKey(kv.Key)
+		if err != nil || len(parts) != 2 {
+			continue
+		}
+		if deadline, _ := strconv.ParseInt(parts[0], 10, 64); deadline >= now {
+			break
+		}
+		reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{parts[1]})
+		reqBytes, err := ctx.GetStub().GetState(reqKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read request %s: %v", parts[1], err)
+		}
+		var req AccessRequest
+		if reqBytes == nil || json.Unmarshal(reqBytes, &req) != nil {
+			continue
+		}
+		// 重新確認狀態與期限，不完全依賴查詢結果
+		if req.Status != "PENDING" || now <= responseDeadline(req, cfg) {
+			continue
+		}
+		req.Status = "EXPIRED"
+		req.DecidedAt = txTime(ctx)
+		req.WriterMSP = getMSPID(ctx)
+		data, _ := json.Marshal(req)
+		if err := ctx.GetStub().PutState(reqKey, data); err != nil {
+			return 0, fmt.Errorf("failed to update request %s", req.RequestID)
+		}
+		released = append(released, req)
+		expired = append(expired, map[string]interface{}{
+			"requestId":     req.RequestID,
+			"reportId":      req.ReportID,
+			"patientHash":   req.PatientHash,
+			"requesterHash": req.RequesterHash,
+		})
+	}
+	if err := releasePendingRequests(ctx, released, false); err != nil {
+		return 0, err
+	}
+
+	if len(expired) > 0 {
+		// 每筆交易只能設定一個事件，因此將本次逾期的請求合併為一個事件
+		eventPayload, _ := json.Marshal(map[string]interface{}{
+			"status":    "EXPIRED",
+			"expiredAt": now,
+			"requests":  expired,
+		})
+		if err := ctx.GetStub().SetEvent("AccessRequestExpired", eventPayload); err != nil {
+			return 0, fmt.Errorf("failed to set event")
+		}
+	}
+	return len(expired), nil
+}
+
+// 病患（或持有 APPROVE 代理授權者）封鎖保險業者，封鎖期間該保險業者無法對病患提出授權請求；
+// 已送出的請求仍需病患自行處理。patientHash 為空代表本人
+func (h *HealthCheckContract) BlockRequester(ctx contractapi.TransactionContextInterface, requesterHash, reason, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	if requesterHash == "" {
+		return fmt.Errorf("requester is required")
+	}
+	key, b, err := getRequesterBlock(ctx, patientHash, requesterHash)
+	if err != nil {
+		return err
+	}
+	if b != nil && !b.Unblocked {
+		return fmt.Errorf("requester already blocked")
+	}
+	data, _ := json.Marshal(RequesterBlock{
+		DocType:       docRequesterBlock,
+		PatientHash:   patientHash,
+		RequesterHash: requesterHash,
+		Reason:        reason,
+		BlockedAt:     txTime(ctx),
+		BlockedBy:     actorHash,
+		WriterMSP:     getMSPID(ctx),
+	})
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store requester block")
+	}
+	return nil
+}
+
+// 病患（或持有 APPROVE 代理授權者）解除封鎖，保留紀錄以利稽核；patientHash 為空代表本人
+func (h *HealthCheckContract) UnblockRequester(ctx contractapi.TransactionContextInterface, requesterHash, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	key, b, err := getRequesterBlock(ctx, patientHash, requesterHash)
+	if err != nil {
+		return err
+	}
+	if b == nil || b.Unblocked {
+		return fmt.Errorf("requester is not blocked")
+	}
+	b.Unblocked = true
+	b.UnblockedAt = txTime(ctx)
+	b.UnblockedBy = actorHash
+	b.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(b)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update requester block")
+	}
+	return nil
+}
+
+// 列出病患目前封鎖中的保險業者；代理人以 patientHash 指定被代理病患，空值為本人
+func (h *HealthCheckContract) ListBlockedRequesters(ctx contractapi.TransactionContextInterface, patientHash string) ([]RequesterBlock, error) {
+	patientHash, _, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyRequesterBlockNS, []string{patientHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read requester blocks: %v", err)
+	}
+	defer iter.Close()
+
+	blocks := []RequesterBlock{}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		var b RequesterBlock
+		if err := json.Unmarshal(kv.Value, &b); err != nil || b.Unblocked {
+			continue
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
@@ -0,0 +1,376 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-chaincode-go/pkg/attrmgr"
+	"github.com/hyperledger/fabric-chaincode-go/shimtest"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	"github.com/hyperledger/fabric-protos-go/msp"
+)
+
+const testReportID = "rep1"
+
+// 正規化後以 LOINC 為鍵的報告，原始代碼保留於 localCode
+const testReportResult = `{
+	"1558-6": {"value": 89, "unit": "mg/dL", "referenceRange": {"low": 70, "high": 99}, "flag": "N", "loinc": "1558-6", "localCode": "Glu-AC"},
+	"2093-3": {"value": 164, "unit": "mg/dL", "referenceRange": {"high": 200}, "flag": "N", "loinc": "2093-3", "localCode": "T-CHO"}
+}`
+
+// testIdentity 為帶有 Fabric CA 屬性的呼叫者憑證
+type testIdentity struct {
+	pseudonym string
+	creator   []byte
+}
+
+func newTestIdentity(t *testing.T, mspID, enrollmentID, role string, extra map[string]string) testIdentity {
+	t.Helper()
+	attrs := map[string]string{"hf.EnrollmentID": enrollmentID, "role": role}
+	if role == "patient" || role == "insurer" {
+		attrs["pseudonym"] = hashID(enrollmentID)
+	}
+	for k, v := range extra {
+		attrs[k] = v
+	}
+	attrJSON, _ := json.Marshal(attrmgr.Attributes{Attrs: attrs})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: enrollmentID},
+		NotBefore:       time.Unix(0, 0).UTC(),
+		NotAfter:        time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+		ExtraExtensions: []pkix.Extension{{Id: attrmgr.AttrOID, Value: attrJSON}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creator, err := proto.Marshal(&msp.SerializedIdentity{
+		Mspid:   mspID,
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testIdentity{pseudonym: attrs["pseudonym"], creator: creator}
+}
+
+// testLedger 以 shimtest 依序執行交易，now 為下一筆交易的時間
+type testLedger struct {
+	t    *testing.T
+	stub *shimtest.MockStub
+	cc   *HealthCheckContract
+	now  int64
+	seq  int
+
+	admin, system, clinic, insurer, patient testIdentity
+}
+
+// newTestLedger 登錄健檢中心與保險業者，並上傳一份報告
+func newTestLedger(t *testing.T) *testLedger {
+	l := &testLedger{
+		t:       t,
+		stub:    shimtest.NewMockStub("health", nil),
+		cc:      new(HealthCheckContract),
+		now:     1700000000,
+		admin:   newTestIdentity(t, "PlatformMSP", "admin1", "admin", nil),
+		system:  newTestIdentity(t, "PlatformMSP", "system1", "system", nil),
+		clinic:  newTestIdentity(t, "ClinicMSP", "clinicUser1", "clinic", map[string]string{"clinicId": "clinic1"}),
+		insurer: newTestIdentity(t, "InsurerMSP", "insurer1", "insurer", nil),
+		patient: newTestIdentity(t, "PatientMSP", "patient1", "patient", nil),
+	}
+	l.must(l.admin, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.RegisterClinic(ctx, "clinic1", "Clinic One", "CL-1", l.now-1000, l.now+100*365*24*3600)
+	})
+	l.must(l.admin, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.RegisterInsurer(ctx, l.insurer.pseudonym, "Acme Life", "INS-1")
+	})
+	l.must(l.clinic, map[string][]byte{transientResultKey: []byte(testReportResult)}, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.UploadReport(ctx, testReportID, l.patient.pseudonym)
+	})
+	return l
+}
+
+// invoke 以指定身分執行一筆交易，並回傳交易發出的事件
+func (l *testLedger) invoke(id testIdentity, transient map[string][]byte, fn func(ctx contractapi.TransactionContextInterface) error) (map[string][]byte, error) {
+	l.seq++
+	txID := "tx" + strconv.Itoa(l.seq)
+	l.stub.MockTransactionStart(txID)
+	defer l.stub.MockTransactionEnd(txID)
+	l.stub.TxTimestamp = &timestamp.Timestamp{Seconds: l.now}
+	l.stub.Creator = id.creator
+	l.stub.TransientMap = transient
+
+	ctx := new(contractapi.TransactionContext)
+	ctx.SetStub(l.stub)
+	err := fn(ctx)
+
+	events := map[string][]byte{}
+	for len(l.stub.ChaincodeEventsChannel) > 0 {
+		ev := <-l.stub.ChaincodeEventsChannel
+		events[ev.EventName] = ev.Payload
+	}
+	return events, err
+}
+
+// must 執行預期成功的交易
+func (l *testLedger) must(id testIdentity, transient map[string][]byte, fn func(ctx contractapi.TransactionContextInterface) error) map[string][]byte {
+	l.t.Helper()
+	events, err := l.invoke(id, transient, fn)
+	if err != nil {
+		l.t.Fatalf("unexpected error: %v", err)
+	}
+	return events
+}
+
+func (l *testLedger) request() (string, error) {
+	var id string
+	_, err := l.invoke(l.insurer, nil, func(ctx contractapi.TransactionContextInterface) error {
+		var err error
+		id, err = l.cc.RequestAccess(ctx, testReportID, l.patient.pseudonym, "underwriting", strconv.FormatInt(l.now+30*24*3600, 10), nil)
+		return err
+	})
+	return id, err
+}
+
+func (l *testLedger) mustRequest() string {
+	l.t.Helper()
+	id, err := l.request()
+	if err != nil {
+		l.t.Fatalf("RequestAccess: %v", err)
+	}
+	return id
+}
+
+func (l *testLedger) approve(requestID string, expiry int64, maxReads int32) error {
+	_, err := l.invoke(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.ApproveAndAuthorizeAccess(ctx, requestID, nil, expiry, maxReads)
+	})
+	return err
+}
+
+func (l *testLedger) recordRead() (string, error) {
+	var receiptID string
+	_, err := l.invoke(l.insurer, nil, func(ctx contractapi.TransactionContextInterface) error {
+		var err error
+		receiptID, err = l.cc.RecordReportRead(ctx, l.patient.pseudonym, testReportID)
+		return err
+	})
+	return receiptID, err
+}
+
+func (l *testLedger) ticket() AuthTicket {
+	l.t.Helper()
+	key, _ := l.stub.CreateCompositeKey(keyAuthNS, []string{l.patient.pseudonym, l.insurer.pseudonym, testReportID})
+	var tk AuthTicket
+	if err := json.Unmarshal(l.stub.State[key], &tk); err != nil {
+		l.t.Fatalf("ticket: %v", err)
+	}
+	return tk
+}
+
+func expectError(t *testing.T, err error, contains string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected error containing %q, got nil", contains)
+	}
+	if !strings.Contains(err.Error(), contains) {
+		t.Fatalf("expected error containing %q, got %q", contains, err.Error())
+	}
+}
+
+func TestRevokeAccess(t *testing.T) {
+	l := newTestLedger(t)
+	revoke := func(id testIdentity) (map[string][]byte, error) {
+		return l.invoke(id, nil, func(ctx contractapi.TransactionContextInterface) error {
+			return l.cc.RevokeAccess(ctx, l.insurer.pseudonym, testReportID, "")
+		})
+	}
+	_, err := revoke(l.patient)
+	expectError(t, err, "ticket not found")
+
+	if err := l.approve(l.mustRequest(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.recordRead(); err != nil {
+		t.Fatalf("RecordReportRead: %v", err)
+	}
+
+	_, err = revoke(l.insurer)
+	expectError(t, err, "only patient or delegate")
+
+	l.now += 60
+	events, err := revoke(l.patient)
+	if err != nil {
+		t.Fatalf("RevokeAccess: %v", err)
+	}
+	if _, ok := events["AccessRevoked"]; !ok {
+		t.Fatal("AccessRevoked event was not emitted")
+	}
+	if tk := l.ticket(); !tk.Revoked || tk.RevokedAt != l.now || tk.RevokedBy != l.patient.pseudonym {
+		t.Fatalf("ticket = %+v", tk)
+	}
+
+	_, err = revoke(l.patient)
+	expectError(t, err, "ticket already revoked")
+	_, err = l.recordRead()
+	expectError(t, err, "access revoked")
+}
+
+func TestTicketExpiry(t *testing.T) {
+	l := newTestLedger(t)
+	if err := l.approve(l.mustRequest(), l.now+3600, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.recordRead(); err != nil {
+		t.Fatalf("RecordReportRead: %v", err)
+	}
+	l.now += 3601
+	_, err := l.recordRead()
+	expectError(t, err, "access expired")
+}
+
+func (l *testLedger) accessRequest(requestID string) AccessRequest {
+	l.t.Helper()
+	key, _ := l.stub.CreateCompositeKey(keyAccessRequestNS, []string{requestID})
+	var req AccessRequest
+	if err := json.Unmarshal(l.stub.State[key], &req); err != nil {
+		l.t.Fatalf("request %s: %v", requestID, err)
+	}
+	return req
+}
+
+func (l *testLedger) expireStale(limit int32) int {
+	l.t.Helper()
+	var n int
+	l.must(l.system, nil, func(ctx contractapi.TransactionContextInterface) error {
+		var err error
+		n, err = l.cc.ExpireStaleAccessRequests(ctx, limit)
+		return err
+	})
+	return n
+}
+
+func TestExpireStaleAccessRequests(t *testing.T) {
+	l := newTestLedger(t)
+	first := l.mustRequest()
+	l.now += 3600
+	second := l.mustRequest()
+	third := l.mustRequest()
+
+	// 只有第一筆超過回應期限
+	l.now += defaultResponseWindow - 1800
+	_, err := l.invoke(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.RejectAccessRequest(ctx, first)
+	})
+	expectError(t, err, "response deadline has passed")
+
+	if n := l.expireStale(10); n != 1 {
+		t.Fatalf("expired %d requests, want 1", n)
+	}
+	if req := l.accessRequest(first); req.Status != "EXPIRED" || req.DecidedAt != l.now {
+		t.Fatalf("request = %+v", req)
+	}
+	if got := l.accessRequest(second).Status; got != "PENDING" {
+		t.Fatalf("status = %s, want PENDING", got)
+	}
+	if n := l.expireStale(10); n != 0 {
+		t.Fatalf("expired %d requests on second sweep, want 0", n)
+	}
+
+	// 已處理的請求不再留在待處理索引
+	if err := l.approve(second, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	l.now += 3600
+	if n := l.expireStale(1); n != 1 {
+		t.Fatalf("expired %d requests, want 1", n)
+	}
+	if got := l.accessRequest(second).Status; got != "APPROVED" {
+		t.Fatalf("status = %s, want APPROVED", got)
+	}
+	if got := l.accessRequest(third).Status; got != "EXPIRED" {
+		t.Fatalf("status = %s, want EXPIRED", got)
+	}
+
+	// 逾期不觸發拒絕後的冷卻期間
+	l.mustRequest()
+}
+
+func TestExpireStaleAccessRequestsRequiresSystem(t *testing.T) {
+	l := newTestLedger(t)
+	_, err := l.invoke(l.admin, nil, func(ctx contractapi.TransactionContextInterface) error {
+		_, err := l.cc.ExpireStaleAccessRequests(ctx, 10)
+		return err
+	})
+	if err == nil {
+		t.Fatal("expected admin sweep to be rejected")
+	}
+}
+
+func TestAccessRequestRejectionCooldown(t *testing.T) {
+	l := newTestLedger(t)
+	reqID := l.mustRequest()
+	l.must(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.RejectAccessRequest(ctx, reqID)
+	})
+	_, err := l.request()
+	expectError(t, err, "request rejected recently")
+
+	l.now += defaultRejectionCooldown + 1
+	l.mustRequest()
+}
+
+func TestAccessRequestPendingLimit(t *testing.T) {
+	l := newTestLedger(t)
+	var ids []string
+	for i := int32(0); i < defaultMaxPendingRequests; i++ {
+		ids = append(ids, l.mustRequest())
+	}
+	_, err := l.request()
+	expectError(t, err, "too many pending requests")
+
+	// 核准後釋出名額
+	if err := l.approve(ids[0], 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	l.mustRequest()
+}
+
+func TestBlockRequester(t *testing.T) {
+	l := newTestLedger(t)
+	block := func() error {
+		_, err := l.invoke(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+			return l.cc.BlockRequester(ctx, l.insurer.pseudonym, "unsolicited requests", "")
+		})
+		return err
+	}
+	if err := block(); err != nil {
+		t.Fatalf("BlockRequester: %v", err)
+	}
+	expectError(t, block(), "requester already blocked")
+	_, err := l.request()
+	expectError(t, err, "requester is blocked by patient")
+
+	l.must(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.UnblockRequester(ctx, l.insurer.pseudonym, "")
+	})
+	l.mustRequest()
+}
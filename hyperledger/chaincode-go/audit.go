@@ -0,0 +1,422 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// 稽核軌跡中的單筆狀態變更
+type AuditEntry struct {
+	ObjectType string `json:"objectType"` // report / ticket / accessRequest / emergencyAccess / readReceipt
+	ObjectKey  string `json:"objectKey"`
+	TxID       string `json:"txId"`
+	Timestamp  int64  `json:"timestamp"`
+	WriterMSP  string `json:"writerMsp"`
+	Operation  string `json:"operation"`
+	Status     string `json:"status,omitempty"`
+}
+
+type ReportAuditTrail struct {
+	ReportID string       `json:"reportId"`
+	Entries  []AuditEntry `json:"entries"`
+}
+
+// 保險業者讀取報告的收據，每次讀取都先以提交交易寫入
+type ReadReceipt struct {
+	DocType         string   `json:"docType"`
+	ReceiptID       string   `json:"receiptId"`
+	ReportID        string   `json:"reportId"`
+	PatientHash     string   `json:"patientHash"`
+	ReaderHash      string   `json:"readerHash"`
+	ReaderMSP       string   `json:"readerMsp"`
+	TicketGrantedAt int64    `json:"ticketGrantedAt"`  // 與 patientHash、readerHash、reportId 一同識別讀取時使用的票據
+	Fields          []string `json:"fields,omitempty"` // 提供的欄位，空值代表完整報告
+	Version         int      `json:"version"`
+	ReadAt          int64    `json:"readAt"`
+	ReadableUntil   int64    `json:"readableUntil"` // 超過此時間無法再以此收據讀取報告
+	WriterMSP       string   `json:"writerMsp,omitempty"`
+}
+
+type ReadReceiptPage struct {
+	Records      []ReadReceipt `json:"records"`
+	Bookmark     string        `json:"bookmark"`
+	FetchedCount int32         `json:"fetchedCount"`
+}
+
+// 依 key history 產生稽核紀錄(internal function)
+// describe 由紀錄內容推導出操作類型、狀態與寫入者 MSP
+func auditKeyHistory(ctx contractapi.TransactionContextInterface, objectType, key string, describe func(value []byte) (op, status, msp string)) ([]AuditEntry, error) {
+	iter, err := ctx.GetStub().GetHistoryForKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history for %s: %v", objectType, err)
+	}
+	defer iter.Close()
+
+	var entries []AuditEntry
+	for iter.HasNext() {
+		km, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		entry := AuditEntry{
+			ObjectType: objectType,
+			ObjectKey:  key,
+			TxID:       km.TxId,
+			Timestamp:  km.Timestamp.GetSeconds(),
+		}
+		if km.IsDelete {
+			entry.Operation = "DELETE"
+		} else {
+			entry.Operation, entry.Status, entry.WriterMSP = describe(km.Value)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// 以 rich query 找出與報告相關的紀錄 key(internal function)
+func queryKeysByReport(ctx contractapi.TransactionContextInterface, docType, reportID string) ([]string, error) {
+	query, err := json.Marshal(map[string]interface{}{
+		"selector": map[string]interface{}{
+			"docType":  docType,
+			"reportId": reportID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	iter, err := ctx.GetStub().GetQueryResult(string(query))
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %v", err)
+	}
+	defer iter.Close()
+
+	var keys []string
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		keys = append(keys, kv.Key)
+	}
+	return keys, nil
+}
+
+/**
+ * @notice 查詢單一報告的完整稽核軌跡：報告本身、授權票據、授權請求與緊急存取的每一次狀態變更
+ * @dev 只允許報告所屬病患或原上傳的 clinic
+ * @param ctx Fabric合約上下文
+ * @param reportID 報告ID
+ * @return ReportAuditTrail 依時間排序的稽核紀錄, error 查詢失敗或無權限
+ */
+func (h *HealthCheckContract) GetReportAuditTrail(ctx contractapi.TransactionContextInterface, reportID string) (*ReportAuditTrail, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+
+	repKey, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	switch role {
+	case "patient":
+		if rep.PatientHash != callerHash(ctx, userID) {
+			return nil, fmt.Errorf("not authorized to audit this report")
+		}
+	case "clinic":
+		if clinicID := getClinicID(ctx); clinicID == "" || rep.ClinicID != clinicID {
+			return nil, fmt.Errorf("not authorized to audit this report")
+		}
+	default:
+		return nil, fmt.Errorf("not authorized to audit this report")
+	}
+
+	trail := &ReportAuditTrail{ReportID: reportID, Entries: []AuditEntry{}}
+
+	// 1. 報告本身
+	entries, err := auditKeyHistory(ctx, "report", repKey, func(value []byte) (string, string, string) {
+		var r HealthReport
+		_ = json.Unmarshal(value, &r)
+		if reportVersion(r) > 1 {
+			return "AMEND", fmt.Sprintf("v%d", r.Version), r.WriterMSP
+		}
+		return "UPLOAD", "v1", r.WriterMSP
+	})
+	if err != nil {
+		return nil, err
+	}
+	trail.Entries = append(trail.Entries, entries...)
+
+	// 2. 授權票據
+	ticketKeys, err := queryKeysByReport(ctx, docAuth, reportID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range ticketKeys {
+		entries, err := auditKeyHistory(ctx, "ticket", key, func(value []byte) (string, string, string) {
+			var tk AuthTicket
+			_ = json.Unmarshal(value, &tk)
+			if tk.Revoked {
+				return "REVOKE", "REVOKED", tk.WriterMSP
+			}
+			// 每次寫入只更新其中一個時間，最新者即為該次操作
+			if tk.LastReadAt > tk.GrantedAt && tk.LastReadAt > tk.ExtendedAt {
+				return "READ", fmt.Sprintf("READ %d", tk.ReadCount), tk.WriterMSP
+			}
+			if tk.ExtendedAt > 0 {
+				return "EXTEND", "ACTIVE", tk.WriterMSP
+			}
+			return "GRANT", "ACTIVE", tk.WriterMSP
+		})
+		if err != nil {
+			return nil, err
+		}
+		trail.Entries = append(trail.Entries, entries...)
+	}
+
+	// 3. 授權請求
+	requestKeys, err := queryKeysByReport(ctx, docAccessRequest, reportID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range requestKeys {
+		entries, err := auditKeyHistory(ctx, "accessRequest", key, func(value []byte) (string, string, string) {
+			var req AccessRequest
+			_ = json.Unmarshal(value, &req)
+			switch req.Status {
+			case "APPROVED":
+				return "APPROVE", req.Status, req.WriterMSP
+			case "REJECTED":
+				return "REJECT", req.Status, req.WriterMSP
+			case "EXPIRED":
+				return "EXPIRE", req.Status, req.WriterMSP
+			default:
+				return "REQUEST", req.Status, req.WriterMSP
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		trail.Entries = append(trail.Entries, entries...)
+	}
+
+	// 4. 讀取收據
+	receiptKeys, err := queryKeysByReport(ctx, docReadReceipt, reportID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range receiptKeys {
+		entries, err := auditKeyHistory(ctx, "readReceipt", key, func(value []byte) (string, string, string) {
+			var rr ReadReceipt
+			_ = json.Unmarshal(value, &rr)
+			return "READ", fmt.Sprintf("v%d", rr.Version), rr.WriterMSP
+		})
+		if err != nil {
+			return nil, err
+		}
+		trail.Entries = append(trail.Entries, entries...)
+	}
+
+	// 5. 緊急存取
+	emergencyKeys, err := queryKeysByReport(ctx, docEmergencyAccess, reportID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range emergencyKeys {
+		entries, err := auditKeyHistory(ctx, "emergencyAccess", key, func(value []byte) (string, string, string) {
+			var ea EmergencyAccess
+			_ = json.Unmarshal(value, &ea)
+			if ea.Acknowledged {
+				return "ACKNOWLEDGE", "ACKNOWLEDGED", ea.WriterMSP
+			}
+			return "BREAK_GLASS", "UNACKNOWLEDGED", ea.WriterMSP
+		})
+		if err != nil {
+			return nil, err
+		}
+		trail.Entries = append(trail.Entries, entries...)
+	}
+
+	sort.SliceStable(trail.Entries, func(i, j int) bool {
+		return trail.Entries[i].Timestamp < trail.Entries[j].Timestamp
+	})
+	return trail, nil
+}
+
+// 讀取收據(internal function)
+func getReadReceipt(ctx contractapi.TransactionContextInterface, receiptID string) (*ReadReceipt, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyReadReceiptNS, []string{receiptID})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read receipt: %v", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("read receipt not found")
+	}
+	var rr ReadReceipt
+	if err := json.Unmarshal(data, &rr); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal read receipt: %v", err)
+	}
+	return &rr, nil
+}
+
+/**
+ * @notice 保險業者讀取報告前寫入讀取收據，每次讀取都須先提交一張收據
+ * @dev 只允許 insurer 身份，必須持有有效的報告內容授權票據；票據設有讀取次數上限時，達上限後拒絕
+ * @param ctx Fabric合約上下文
+ * @param patientHash 病患hash
+ * @param reportID 報告ID
+ * @return string 收據ID，供 ReadAuthorizedReport 使用, error 無權限或已達讀取上限
+ */
+func (h *HealthCheckContract) RecordReportRead(ctx contractapi.TransactionContextInterface, patientHash, reportID string) (string, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return "", fmt.Errorf("only insurer can read report")
+	}
+	readerHash := callerHash(ctx, userID)
+
+	tk, err := findValidTicket(ctx, patientHash, readerHash, reportID)
+	if err != nil {
+		return "", err
+	}
+	if tk.AccessMode == accessModePredicate {
+		return "", fmt.Errorf("ticket only permits predicate evaluation")
+	}
+	_, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return "", err
+	}
+
+	// 票據記錄讀取次數，讀取上限以票據的版本控制避免同時讀取超過上限
+	now := txTime(ctx)
+	tk.ReadCount++
+	tk.LastReadAt = now
+	tk.Exhausted = readLimitReached(*tk)
+	tk.WriterMSP = getMSPID(ctx)
+	if err := putTicket(ctx, *tk); err != nil {
+		return "", err
+	}
+
+	rr := ReadReceipt{
+		DocType:         docReadReceipt,
+		ReceiptID:       "rd_" + ctx.GetStub().GetTxID(),
+		ReportID:        reportID,
+		PatientHash:     patientHash,
+		ReaderHash:      readerHash,
+		ReaderMSP:       getMSPID(ctx),
+		TicketGrantedAt: tk.GrantedAt,
+		Fields:          tk.GrantedFields,
+		Version:         reportVersion(*rep),
+		ReadAt:          now,
+		ReadableUntil:   now + readReceiptWindow,
+		WriterMSP:       getMSPID(ctx),
+	}
+	key, _ := ctx.GetStub().CreateCompositeKey(keyReadReceiptNS, []string{rr.ReceiptID})
+	data, _ := json.Marshal(rr)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return "", fmt.Errorf("failed to store read receipt")
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"receiptId":   rr.ReceiptID,
+		"reportId":    rr.ReportID,
+		"patientHash": rr.PatientHash,
+		"readerHash":  rr.ReaderHash,
+		"readAt":      rr.ReadAt,
+		"fields":      rr.Fields,
+		"version":     rr.Version,
+		"readCount":   tk.ReadCount,
+		"maxReads":    tk.MaxReads,
+	})
+	if err := ctx.GetStub().SetEvent("ReportRead", eventPayload); err != nil {
+		return "", fmt.Errorf("failed to set event")
+	}
+	return rr.ReceiptID, nil
+}
+
+/**
+ * @notice 保險業者憑讀取收據讀取已授權的健檢報告內容
+ * @dev 僅供 evaluate，不寫入任何狀態：收據須由先前已提交的 RecordReportRead 寫入，
+ *      只允許收據的讀取者在收據有效時間內讀取，且寫入收據時的票據仍須有效
+ *      （撤銷後重新核發的票據不沿用舊收據）；只回傳收據記錄的欄位。
+ *      讀取次數已在 RecordReportRead 計入票據，因此這裡不檢查讀取上限
+ * @param ctx Fabric合約上下文
+ * @param receiptID RecordReportRead 回傳的收據ID
+ * @return ReportContent 報告內容與版本, error 查詢失敗或無權限
+ */
+func (h *HealthCheckContract) ReadAuthorizedReport(ctx contractapi.TransactionContextInterface, receiptID string) (*ReportContent, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return nil, fmt.Errorf("only insurer can read report")
+	}
+	rr, err := getReadReceipt(ctx, receiptID)
+	if err != nil {
+		return nil, err
+	}
+	if rr.ReaderHash != callerHash(ctx, userID) {
+		return nil, fmt.Errorf("not authorized to use this read receipt")
+	}
+	if txTime(ctx) > rr.ReadableUntil {
+		return nil, fmt.Errorf("read receipt has expired")
+	}
+	tk, err := findIssuedTicket(ctx, rr.PatientHash, rr.ReaderHash, rr.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	if tk.GrantedAt != rr.TicketGrantedAt {
+		return nil, fmt.Errorf("ticket changed since read receipt was issued")
+	}
+
+	repKey, rep, err := getReport(ctx, rr.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	resultJSON, err := readReportResult(ctx, repKey, *rep)
+	if err != nil {
+		return nil, err
+	}
+	// 只回傳收據記錄的欄位
+	resultJSON, err = redactResult(resultJSON, rr.Fields)
+	if err != nil {
+		return nil, err
+	}
+	content := toReportContent(*rep, resultJSON)
+	return &content, nil
+}
+
+// 病患（或持有 READ 代理授權者）查詢保險業者讀取其報告的紀錄，filter.RequesterHash 可篩選保險業者
+func (h *HealthCheckContract) ListAccessLog(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*ReadReceiptPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"docType":     docReadReceipt,
+		"patientHash": patientHash,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["readAt"] = r
+	}
+	if filter.RequesterHash != "" {
+		selector["readerHash"] = filter.RequesterHash
+	}
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &ReadReceiptPage{Records: []ReadReceipt{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var rr ReadReceipt
+		if err := json.Unmarshal(v, &rr); err != nil {
+			continue
+		}
+		page.Records = append(page.Records, rr)
+	}
+	return page, nil
+}
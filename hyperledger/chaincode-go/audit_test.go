@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+func (l *testLedger) readReport(id testIdentity, receiptID string) (*ReportContent, error) {
+	var content *ReportContent
+	_, err := l.invoke(id, nil, func(ctx contractapi.TransactionContextInterface) error {
+		var err error
+		content, err = l.cc.ReadAuthorizedReport(ctx, receiptID)
+		return err
+	})
+	return content, err
+}
+
+func TestReadAuthorizedReport(t *testing.T) {
+	l := newTestLedger(t)
+	var reqID string
+	l.must(l.insurer, nil, func(ctx contractapi.TransactionContextInterface) error {
+		var err error
+		reqID, err = l.cc.RequestAccess(ctx, testReportID, l.patient.pseudonym, "underwriting",
+			strconv.FormatInt(l.now+30*24*3600, 10), []string{"Glu-AC", "T-CHO"})
+		return err
+	})
+	// 病患只核准其中一個欄位
+	l.must(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.ApproveAndAuthorizeAccess(ctx, reqID, []string{"Glu-AC"}, 0, 0)
+	})
+
+	receiptID, err := l.recordRead()
+	if err != nil {
+		t.Fatalf("RecordReportRead: %v", err)
+	}
+	if tk := l.ticket(); tk.ReadCount != 1 {
+		t.Fatalf("read count = %d, want 1", tk.ReadCount)
+	}
+
+	// 收據有效期間內可重複讀取，讀取本身不寫入帳本
+	for i := 0; i < 2; i++ {
+		content, err := l.readReport(l.insurer, receiptID)
+		if err != nil {
+			t.Fatalf("ReadAuthorizedReport: %v", err)
+		}
+		var result map[string]LabResult
+		if err := json.Unmarshal([]byte(content.ResultJSON), &result); err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 1 || result["1558-6"].LocalCode != "Glu-AC" {
+			t.Fatalf("redacted result = %s", content.ResultJSON)
+		}
+	}
+	if tk := l.ticket(); tk.ReadCount != 1 {
+		t.Fatalf("read count = %d after evaluate, want 1", tk.ReadCount)
+	}
+
+	_, err = l.readReport(l.patient, receiptID)
+	expectError(t, err, "only insurer can read report")
+	other := newTestIdentity(t, "InsurerMSP", "insurer2", "insurer", nil)
+	_, err = l.readReport(other, receiptID)
+	expectError(t, err, "not authorized to use this read receipt")
+	_, err = l.readReport(l.insurer, "rd_missing")
+	if err == nil {
+		t.Fatal("expected unknown receipt to be rejected")
+	}
+
+	l.now += readReceiptWindow + 1
+	_, err = l.readReport(l.insurer, receiptID)
+	expectError(t, err, "read receipt has expired")
+}
+
+func TestReadLimit(t *testing.T) {
+	l := newTestLedger(t)
+	if err := l.approve(l.mustRequest(), 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	receiptID, err := l.recordRead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk := l.ticket(); !tk.Exhausted || tk.ReadCount != 1 {
+		t.Fatalf("ticket = %+v, want exhausted after one read", tk)
+	}
+	_, err = l.recordRead()
+	expectError(t, err, "read limit reached")
+
+	// 已計入次數的收據仍可讀取
+	content, err := l.readReport(l.insurer, receiptID)
+	if err != nil {
+		t.Fatalf("ReadAuthorizedReport: %v", err)
+	}
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content.ResultJSON), &result); err != nil || len(result) != 2 {
+		t.Fatalf("full report expected, got %s", content.ResultJSON)
+	}
+}
+
+func TestReadReceiptAfterRevocation(t *testing.T) {
+	l := newTestLedger(t)
+	if err := l.approve(l.mustRequest(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	receiptID, err := l.recordRead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.must(l.patient, nil, func(ctx contractapi.TransactionContextInterface) error {
+		return l.cc.RevokeAccess(ctx, l.insurer.pseudonym, testReportID, "")
+	})
+	_, err = l.readReport(l.insurer, receiptID)
+	expectError(t, err, "access revoked")
+
+	// 重新核發的票據不沿用撤銷前的收據
+	l.now += 60
+	if err := l.approve(l.mustRequest(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.readReport(l.insurer, receiptID)
+	expectError(t, err, "ticket changed since read receipt was issued")
+}
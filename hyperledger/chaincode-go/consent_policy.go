@@ -0,0 +1,446 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// 同意政策：病患預先授權特定保險業者，符合條件的授權請求自動核准
+type ConsentPolicy struct {
+	DocType     string   `json:"docType"`
+	PolicyID    string   `json:"policyId"`
+	PatientHash string   `json:"patientHash"`
+	InsurerHash string   `json:"insurerHash"`
+	ClinicIDs   []string `json:"clinicIds,omitempty"` // 空值代表所有健檢中心
+	ValidFrom   int64    `json:"validFrom"`           // 政策有效期間，也限定報告建立時間
+	ValidUntil  int64    `json:"validUntil"`
+	Fields      []string `json:"fields,omitempty"`   // 空值代表完整報告
+	AutoPush    bool     `json:"autoPush,omitempty"` // 上傳報告時主動核發票據
+	Revoked     bool     `json:"revoked,omitempty"`
+	RevokedAt   int64    `json:"revokedAt,omitempty"`
+	CreatedAt   int64    `json:"createdAt"`
+	UpdatedAt   int64    `json:"updatedAt,omitempty"`
+	CreatedBy   string   `json:"createdBy,omitempty"` // 實際操作者假名，代理人代為操作時與 patientHash 不同
+	UpdatedBy   string   `json:"updatedBy,omitempty"`
+	RevokedBy   string   `json:"revokedBy,omitempty"`
+	WriterMSP   string   `json:"writerMsp,omitempty"`
+}
+
+type ConsentPolicyPage struct {
+	Records      []ConsentPolicy `json:"records"`
+	Bookmark     string          `json:"bookmark"`
+	FetchedCount int32           `json:"fetchedCount"`
+}
+
+// 同意政策的複合鍵 CONSENT_POLICY~patientHash~insurerHash~policyID(internal function)
+func consentPolicyKey(ctx contractapi.TransactionContextInterface, p ConsentPolicy) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(keyConsentPolicyNS, []string{p.PatientHash, p.InsurerHash, p.PolicyID})
+	if err != nil {
+		return "", fmt.Errorf("failed to create consent policy key: %v", err)
+	}
+	return key, nil
+}
+
+// 讀取病患的同意政策(internal function)，只在該病患的政策範圍內查找
+func getConsentPolicy(ctx contractapi.TransactionContextInterface, patientHash, policyID string) (string, *ConsentPolicy, error) {
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyConsentPolicyNS, []string{patientHash})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read consent policy: %v", err)
+	}
+	defer iter.Close()
+
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read consent policy: %v", err)
+		}
+		_, attrs, err := ctx.GetStub().SplitCompositeKey(kv.Key)
+		if err != nil || len(attrs) != 3 || attrs[2] != policyID {
+			continue
+		}
+		var p ConsentPolicy
+		if err := json.Unmarshal(kv.Value, &p); err != nil {
+			return "", nil, fmt.Errorf("failed to unmarshal consent policy: %v", err)
+		}
+		return kv.Key, &p, nil
+	}
+	return "", nil, fmt.Errorf("consent policy not found")
+}
+
+// 查詢病患未撤銷的同意政策(internal function)，insurerHash 為空時不限保險業者
+func findConsentPolicies(ctx contractapi.TransactionContextInterface, patientHash, insurerHash string) ([]ConsentPolicy, error) {
+	attrs := []string{patientHash}
+	if insurerHash != "" {
+		attrs = append(attrs, insurerHash)
+	}
+	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyConsentPolicyNS, attrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read consent policies: %v", err)
+	}
+	defer iter.Close()
+
+	var policies []ConsentPolicy
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		var p ConsentPolicy
+		if err := json.Unmarshal(kv.Value, &p); err != nil || p.Revoked {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// 同意政策是否涵蓋此報告(internal function)：政策在有效期間內、報告建立於期間內且來自指定健檢中心
+func policyCoversReport(p ConsentPolicy, rep HealthReport, now int64) bool {
+	if p.Revoked || now < p.ValidFrom || now > p.ValidUntil {
+		return false
+	}
+	if rep.CreatedAt < p.ValidFrom || rep.CreatedAt > p.ValidUntil {
+		return false
+	}
+	if len(p.ClinicIDs) == 0 {
+		return true
+	}
+	for _, c := range p.ClinicIDs {
+		if c == rep.ClinicID {
+			return true
+		}
+	}
+	return false
+}
+
+// 依同意政策可核准的欄位(internal function)；ok 為 false 代表請求超出政策範圍
+func policyGrantedFields(p ConsentPolicy, req AccessRequest) (granted []string, ok bool) {
+	if len(p.Fields) == 0 {
+		return req.RequestedFields, true
+	}
+	allowed := make(map[string]bool)
+	for _, f := range p.Fields {
+		allowed[f] = true
+	}
+	if req.AccessMode == accessModePredicate {
+		for _, pr := range req.Predicates {
+			if !allowed[pr.Field] {
+				return nil, false
+			}
+		}
+		return nil, true
+	}
+	// 請求完整報告時只核准政策允許的欄位
+	if len(req.RequestedFields) == 0 {
+		return p.Fields, true
+	}
+	for _, f := range req.RequestedFields {
+		if !allowed[f] {
+			return nil, false
+		}
+	}
+	return req.RequestedFields, true
+}
+
+// 依同意政策核准或核發時記錄的決定者(internal function)，與病患或代理人的假名區分
+func policyActor(p ConsentPolicy) string {
+	return "policy:" + p.PolicyID
+}
+
+// 依同意政策自動核准授權請求(internal function)：找到符合的政策時核發票據並將請求標記為 APPROVED
+func autoApprove(ctx contractapi.TransactionContextInterface, req *AccessRequest) error {
+	policies, err := findConsentPolicies(ctx, req.PatientHash, req.RequesterHash)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+	_, rep, err := getReport(ctx, req.ReportID)
+	if err != nil {
+		return err
+	}
+	now := txTime(ctx)
+	for _, p := range policies {
+		if !policyCoversReport(p, *rep, now) {
+			continue
+		}
+		granted, ok := policyGrantedFields(p, *req)
+		if !ok {
+			continue
+		}
+
+		// 票據不超過政策有效期間
+		expiry := req.Expiry
+		if expiry > p.ValidUntil {
+			expiry = p.ValidUntil
+		}
+		req.Status = "APPROVED"
+		req.DecidedAt = txTime(ctx)
+		req.GrantedFields = granted
+		req.GrantedExpiry = expiry
+		req.DecidedBy = policyActor(p)
+		req.PolicyID = p.PolicyID
+		if err := putTicket(ctx, AuthTicket{
+			DocType:       docAuth,
+			PatientHash:   req.PatientHash,
+			TargetHash:    req.RequesterHash,
+			ReportID:      req.ReportID,
+			GrantedAt:     now,
+			Expiry:        expiry,
+			GrantedFields: granted,
+			AccessMode:    req.AccessMode,
+			Predicates:    req.Predicates,
+			GrantedBy:     policyActor(p),
+			PolicyID:      p.PolicyID,
+			WriterMSP:     getMSPID(ctx),
+		}); err != nil {
+			return err
+		}
+
+		eventPayload, _ := json.Marshal(map[string]interface{}{
+			"requestId":       req.RequestID,
+			"reportId":        req.ReportID,
+			"patientHash":     req.PatientHash,
+			"requesterHash":   req.RequesterHash,
+			"status":          "APPROVED",
+			"grantedAt":       now,
+			"expiry":          expiry,
+			"grantedFields":   granted,
+			"requestedExpiry": req.Expiry,
+			"requestedFields": req.RequestedFields,
+			"accessMode":      req.AccessMode,
+			"policyId":        p.PolicyID,
+			"actorHash":       policyActor(p),
+		})
+		if err := ctx.GetStub().SetEvent("AccessApproved", eventPayload); err != nil {
+			return fmt.Errorf("failed to set event")
+		}
+		return nil
+	}
+	return nil
+}
+
+// 依病患設定 AutoPush 的同意政策，上傳報告時主動核發票據(internal function)
+func pushPolicyTickets(ctx contractapi.TransactionContextInterface, rep HealthReport) error {
+	policies, err := findConsentPolicies(ctx, rep.PatientHash, "")
+	if err != nil {
+		return err
+	}
+	now := txTime(ctx)
+	var pushed []map[string]interface{}
+	for _, p := range policies {
+		if !p.AutoPush || !policyCoversReport(p, rep, now) {
+			continue
+		}
+		// 停權或未登錄的保險業者不主動核發
+		if _, insurer, err := getInsurer(ctx, p.InsurerHash); err != nil || insurer.Status != "ACTIVE" {
+			continue
+		}
+		if err := putTicket(ctx, AuthTicket{
+			DocType:       docAuth,
+			PatientHash:   rep.PatientHash,
+			TargetHash:    p.InsurerHash,
+			ReportID:      rep.ReportID,
+			GrantedAt:     now,
+			Expiry:        p.ValidUntil,
+			GrantedFields: p.Fields,
+			GrantedBy:     policyActor(p),
+			PolicyID:      p.PolicyID,
+			WriterMSP:     getMSPID(ctx),
+		}); err != nil {
+			return err
+		}
+		pushed = append(pushed, map[string]interface{}{
+			"policyId":      p.PolicyID,
+			"requesterHash": p.InsurerHash,
+			"expiry":        p.ValidUntil,
+		})
+	}
+	if len(pushed) == 0 {
+		return nil
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"reportId":    rep.ReportID,
+		"patientHash": rep.PatientHash,
+		"tickets":     pushed,
+	})
+	if err := ctx.GetStub().SetEvent("PolicyTicketsIssued", eventPayload); err != nil {
+		return fmt.Errorf("failed to set event")
+	}
+	return nil
+}
+
+// 檢查同意政策參數(internal function)
+func validateConsentPolicy(ctx contractapi.TransactionContextInterface, validFrom, validUntil int64) error {
+	if validUntil <= validFrom || validUntil <= txTime(ctx) {
+		return fmt.Errorf("invalid policy period")
+	}
+	return nil
+}
+
+/**
+ * @notice 病患建立同意政策，預先授權特定保險業者
+ * @dev 病患本人或持有 APPROVE 代理授權者；保險業者必須已登錄且未停權。政策有效期間內，該保險業者對期間內建立、
+ *      來自指定健檢中心報告的授權請求會自動核准；autoPush 為 true 時上傳報告即主動核發票據
+ * @param ctx Fabric合約上下文
+ * @param insurerHash 保險業者假名
+ * @param clinicIDs 限定的健檢中心，空值代表全部
+ * @param validFrom 有效起始時間（Unix 秒）
+ * @param validUntil 有效結束時間（Unix 秒），也是自動核發票據的最長期限
+ * @param fields 限定的檢驗欄位，空值代表完整報告
+ * @param autoPush 上傳報告時是否主動核發票據
+ * @param patientHash 代理人操作時為被代理病患假名，空值為本人
+ * @return string policyID, error 權限或參數錯誤
+ */
+func (h *HealthCheckContract) CreateConsentPolicy(ctx contractapi.TransactionContextInterface, insurerHash string, clinicIDs []string, validFrom, validUntil int64, fields []string, autoPush bool, patientHash string) (string, error) {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return "", err
+	}
+	if err := validateConsentPolicy(ctx, validFrom, validUntil); err != nil {
+		return "", err
+	}
+	_, insurer, err := getInsurer(ctx, insurerHash)
+	if err != nil {
+		return "", err
+	}
+	if insurer.Status != "ACTIVE" {
+		return "", fmt.Errorf("insurer license is suspended")
+	}
+
+	p := ConsentPolicy{
+		DocType:     docConsentPolicy,
+		PolicyID:    "pol_" + ctx.GetStub().GetTxID(),
+		PatientHash: patientHash,
+		InsurerHash: insurerHash,
+		ClinicIDs:   normalizeFields(clinicIDs),
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+		Fields:      normalizeFields(fields),
+		AutoPush:    autoPush,
+		CreatedAt:   txTime(ctx),
+		CreatedBy:   actorHash,
+		WriterMSP:   getMSPID(ctx),
+	}
+	key, err := consentPolicyKey(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	data, _ := json.Marshal(p)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return "", fmt.Errorf("failed to store consent policy")
+	}
+	return p.PolicyID, nil
+}
+
+// 病患（或持有 APPROVE 代理授權者）更新同意政策的條件，已核發的票據不受影響；patientHash 為空代表本人
+func (h *HealthCheckContract) UpdateConsentPolicy(ctx contractapi.TransactionContextInterface, policyID string, clinicIDs []string, validFrom, validUntil int64, fields []string, autoPush bool, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	key, p, err := getConsentPolicy(ctx, patientHash, policyID)
+	if err != nil {
+		return err
+	}
+	if p.Revoked {
+		return fmt.Errorf("consent policy already revoked")
+	}
+	if err := validateConsentPolicy(ctx, validFrom, validUntil); err != nil {
+		return err
+	}
+
+	p.ClinicIDs = normalizeFields(clinicIDs)
+	p.ValidFrom = validFrom
+	p.ValidUntil = validUntil
+	p.Fields = normalizeFields(fields)
+	p.AutoPush = autoPush
+	p.UpdatedAt = txTime(ctx)
+	p.UpdatedBy = actorHash
+	p.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(p)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update consent policy")
+	}
+	return nil
+}
+
+// 病患（或持有 APPROVE 代理授權者）撤銷同意政策，保留紀錄以利稽核；已核發的票據需另以 RevokeAccess 撤銷
+func (h *HealthCheckContract) RevokeConsentPolicy(ctx contractapi.TransactionContextInterface, policyID, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	key, p, err := getConsentPolicy(ctx, patientHash, policyID)
+	if err != nil {
+		return err
+	}
+	if p.Revoked {
+		return fmt.Errorf("consent policy already revoked")
+	}
+	p.Revoked = true
+	p.RevokedAt = txTime(ctx)
+	p.UpdatedAt = p.RevokedAt
+	p.RevokedBy = actorHash
+	p.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(p)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update consent policy")
+	}
+	return nil
+}
+
+// 列出同意政策：patient 為自己（或持有 READ 代理授權時 filter.PatientHash 指定的病患）建立的政策，
+// insurer 為以自己為對象的政策。filter.Status 可為 ACTIVE / REVOKED，日期區間以建立時間篩選，病患可以 filter.RequesterHash 篩選保險業者
+func (h *HealthCheckContract) ListMyConsentPolicies(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*ConsentPolicyPage, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+	if filter.ClinicID != "" {
+		return nil, fmt.Errorf("clinic filter is not supported for consent policies")
+	}
+	selector := map[string]interface{}{"docType": docConsentPolicy}
+	switch role {
+	case "patient":
+		patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeRead)
+		if err != nil {
+			return nil, err
+		}
+		selector["patientHash"] = patientHash
+		if filter.RequesterHash != "" {
+			selector["insurerHash"] = filter.RequesterHash
+		}
+	case "insurer":
+		selector["insurerHash"] = callerHash(ctx, userID)
+	default:
+		return nil, fmt.Errorf("only patient or insurer can list consent policies")
+	}
+	if r := dateRange(filter); r != nil {
+		selector["createdAt"] = r
+	}
+	switch filter.Status {
+	case "":
+	case "ACTIVE":
+		selector["revoked"] = map[string]interface{}{"$ne": true}
+	case "REVOKED":
+		selector["revoked"] = true
+	default:
+		return nil, fmt.Errorf("invalid status filter: %s", filter.Status)
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &ConsentPolicyPage{Records: []ConsentPolicy{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var p ConsentPolicy
+		if err := json.Unmarshal(v, &p); err != nil {
+			continue
+		}
+		page.Records = append(page.Records, p)
+	}
+	return page, nil
+}
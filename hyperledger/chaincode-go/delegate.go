@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// 代理授權：監護人或照護者代替病患處理授權請求或讀取報告
+type Delegation struct {
+	DocType      string `json:"docType"`
+	PatientHash  string `json:"patientHash"`
+	DelegateHash string `json:"delegateHash"`
+	Scope        string `json:"scope"` // APPROVE / READ / ALL
+	GrantedAt    int64  `json:"grantedAt"`
+	Expiry       int64  `json:"expiry,omitempty"` // 0 代表不過期
+	Revoked      bool   `json:"revoked,omitempty"`
+	RevokedAt    int64  `json:"revokedAt,omitempty"`
+	WriterMSP    string `json:"writerMsp,omitempty"`
+}
+
+// 讀取代理授權(internal function)
+func getDelegation(ctx contractapi.TransactionContextInterface, patientHash, delegateHash string) (string, *Delegation, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyDelegationNS, []string{patientHash, delegateHash})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read delegation: %v", err)
+	}
+	if data == nil {
+		return key, nil, fmt.Errorf("delegation not found")
+	}
+	var d Delegation
+	if err := json.Unmarshal(data, &d); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal delegation: %v", err)
+	}
+	return key, &d, nil
+}
+
+// 取得本次操作代表的病患(internal function)：patientHash 為空或為呼叫者本人時直接回傳本人，
+// 否則呼叫者必須持有涵蓋 scope 的有效代理授權；actorHash 為實際操作者，供寫入紀錄
+func actingPatient(ctx contractapi.TransactionContextInterface, patientHash, scope string) (acting, actorHash string, err error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "patient" {
+		return "", "", fmt.Errorf("only patient or delegate can perform this operation")
+	}
+	actorHash = callerHash(ctx, userID)
+	if patientHash == "" || patientHash == actorHash {
+		return actorHash, actorHash, nil
+	}
+
+	_, d, err := getDelegation(ctx, patientHash, actorHash)
+	if err != nil {
+		return "", "", fmt.Errorf("not authorized to act for this patient")
+	}
+	if d.Revoked || (d.Expiry > 0 && txTime(ctx) > d.Expiry) {
+		return "", "", fmt.Errorf("delegation is no longer valid")
+	}
+	if d.Scope != delegateScopeAll && d.Scope != scope {
+		return "", "", fmt.Errorf("delegation does not cover %s", scope)
+	}
+	return patientHash, actorHash, nil
+}
+
+/**
+ * @notice 病患指定代理人（監護人或照護者），可設定範圍與到期時間
+ * @dev 只允許病患本人；重複指定同一代理人會覆蓋原本的範圍與期限
+ * @param ctx Fabric合約上下文
+ * @param delegateHash 代理人假名（代理人須為已註冊的病患帳號）
+ * @param scope APPROVE / READ / ALL
+ * @param expiry 到期時間（Unix 秒），0 代表不過期
+ * @return error 權限或參數錯誤
+ */
+func (h *HealthCheckContract) GrantDelegate(ctx contractapi.TransactionContextInterface, delegateHash, scope string, expiry int64) error {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "patient" {
+		return fmt.Errorf("only patient can grant delegate")
+	}
+	patientHash := callerHash(ctx, userID)
+	if !isHexHash(delegateHash) || delegateHash == patientHash {
+		return fmt.Errorf("invalid delegate")
+	}
+	switch scope {
+	case delegateScopeApprove, delegateScopeRead, delegateScopeAll:
+	default:
+		return fmt.Errorf("invalid delegate scope: %s", scope)
+	}
+	if expiry != 0 && expiry <= txTime(ctx) {
+		return fmt.Errorf("invalid expiry")
+	}
+
+	key, _ := ctx.GetStub().CreateCompositeKey(keyDelegationNS, []string{patientHash, delegateHash})
+	d := Delegation{
+		DocType:      docDelegation,
+		PatientHash:  patientHash,
+		DelegateHash: delegateHash,
+		Scope:        scope,
+		GrantedAt:    txTime(ctx),
+		Expiry:       expiry,
+		WriterMSP:    getMSPID(ctx),
+	}
+	data, _ := json.Marshal(d)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store delegation")
+	}
+	return nil
+}
+
+// 病患撤銷代理人，保留紀錄以利稽核
+func (h *HealthCheckContract) RevokeDelegate(ctx contractapi.TransactionContextInterface, delegateHash string) error {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "patient" {
+		return fmt.Errorf("only patient can revoke delegate")
+	}
+	key, d, err := getDelegation(ctx, callerHash(ctx, userID), delegateHash)
+	if err != nil {
+		return err
+	}
+	if d.Revoked {
+		return fmt.Errorf("delegation already revoked")
+	}
+	d.Revoked = true
+	d.RevokedAt = txTime(ctx)
+	d.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(d)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update delegation")
+	}
+	return nil
+}
+
+// 列出代理授權：asDelegate 為 false 時列出自己指定的代理人，為 true 時列出自己被指定代理的病患
+func (h *HealthCheckContract) ListMyDelegations(ctx contractapi.TransactionContextInterface, asDelegate bool) ([]Delegation, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "patient" {
+		return nil, fmt.Errorf("only patient can list delegations")
+	}
+	field := "patientHash"
+	if asDelegate {
+		field = "delegateHash"
+	}
+	query, _ := json.Marshal(map[string]interface{}{
+		"selector": map[string]interface{}{
+			"docType": docDelegation,
+			field:     callerHash(ctx, userID),
+		},
+	})
+	iter, err := ctx.GetStub().GetQueryResult(string(query))
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %v", err)
+	}
+	defer iter.Close()
+
+	delegations := []Delegation{}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		var d Delegation
+		if err := json.Unmarshal(kv.Value, &d); err != nil {
+			continue
+		}
+		delegations = append(delegations, d)
+	}
+	return delegations, nil
+}
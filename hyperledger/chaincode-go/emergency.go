@@ -0,0 +1,209 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// 緊急存取（break-glass）紀錄，病患事後必須確認
+type EmergencyAccess struct {
+	DocType        string `json:"docType"`
+	AccessID       string `json:"accessId"`
+	ReportID       string `json:"reportId"`
+	PatientHash    string `json:"patientHash"`
+	ActorID        string `json:"actorId"`
+	ActorMSP       string `json:"actorMsp"`
+	Justification  string `json:"justification"`
+	AccessedAt     int64  `json:"accessedAt"`
+	ReadableUntil  int64  `json:"readableUntil"` // 超過此時間無法再以此紀錄讀取報告
+	Acknowledged   bool   `json:"acknowledged"`
+	AcknowledgedAt int64  `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy string `json:"acknowledgedBy,omitempty"` // 實際確認者假名，代理人代為確認時與 patientHash 不同
+	WriterMSP      string `json:"writerMsp,omitempty"`
+}
+
+type EmergencyAccessPage struct {
+	Records      []EmergencyAccess `json:"records"`
+	Bookmark     string            `json:"bookmark"`
+	FetchedCount int32             `json:"fetchedCount"`
+}
+
+// 讀取緊急存取紀錄(internal function)
+func getEmergencyAccess(ctx contractapi.TransactionContextInterface, accessID string) (string, *EmergencyAccess, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyEmergencyAccessNS, []string{accessID})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read emergency access: %v", err)
+	}
+	if data == nil {
+		return "", nil, fmt.Errorf("emergency access not found")
+	}
+	var ea EmergencyAccess
+	if err := json.Unmarshal(data, &ea); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal emergency access: %v", err)
+	}
+	return key, &ea, nil
+}
+
+/**
+ * @notice 緊急存取（break-glass）：病患無法核准時，由 emergency 身份寫入存取紀錄
+ * @dev 必須以 submit 呼叫，紀錄理由、操作者與時間並發出 BreakGlassAccess 事件；
+ *      報告內容不放在交易回應（回應會寫入區塊），改由 ReadBreakGlassReport 在 breakGlassReadWindow 內讀取；
+ *      每次讀取都必須先寫入一筆紀錄，讓每次讀取都留在鏈上
+ * @param ctx Fabric合約上下文
+ * @param patientHash 病患假名
+ * @param reportID 報告ID
+ * @param justification 緊急存取理由
+ * @return string accessID, error 權限或參數錯誤
+ */
+func (h *HealthCheckContract) BreakGlassRead(ctx contractapi.TransactionContextInterface, patientHash, reportID, justification string) (string, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "emergency" {
+		return "", fmt.Errorf("only emergency staff can break glass")
+	}
+	if strings.TrimSpace(justification) == "" {
+		return "", fmt.Errorf("justification is required")
+	}
+	_, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return "", err
+	}
+	if rep.PatientHash != patientHash {
+		return "", fmt.Errorf("report does not belong to this patient")
+	}
+
+	now := txTime(ctx)
+	ea := EmergencyAccess{
+		DocType:       docEmergencyAccess,
+		AccessID:      "bg_" + ctx.GetStub().GetTxID(),
+		ReportID:      reportID,
+		PatientHash:   patientHash,
+		ActorID:       userID,
+		ActorMSP:      getMSPID(ctx),
+		Justification: justification,
+		AccessedAt:    now,
+		ReadableUntil: now + breakGlassReadWindow,
+		WriterMSP:     getMSPID(ctx),
+	}
+	key, _ := ctx.GetStub().CreateCompositeKey(keyEmergencyAccessNS, []string{ea.AccessID})
+	data, _ := json.Marshal(ea)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return "", fmt.Errorf("failed to store emergency access")
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"accessId":      ea.AccessID,
+		"reportId":      ea.ReportID,
+		"patientHash":   ea.PatientHash,
+		"actorId":       ea.ActorID,
+		"actorMsp":      ea.ActorMSP,
+		"justification": ea.Justification,
+		"accessedAt":    ea.AccessedAt,
+	})
+	if err := ctx.GetStub().SetEvent("BreakGlassAccess", eventPayload); err != nil {
+		return "", fmt.Errorf("failed to set event")
+	}
+	return ea.AccessID, nil
+}
+
+// 以緊急存取紀錄讀取報告內容，僅供 evaluate；只允許紀錄的操作者，且只在紀錄寫入後的 breakGlassReadWindow 內
+func (h *HealthCheckContract) ReadBreakGlassReport(ctx contractapi.TransactionContextInterface, accessID string) (*ReportContent, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "emergency" {
+		return nil, fmt.Errorf("only emergency staff can read break-glass report")
+	}
+	_, ea, err := getEmergencyAccess(ctx, accessID)
+	if err != nil {
+		return nil, err
+	}
+	if ea.ActorID != userID || ea.ActorMSP != getMSPID(ctx) {
+		return nil, fmt.Errorf("not authorized to use this emergency access")
+	}
+	if txTime(ctx) > ea.ReadableUntil {
+		return nil, fmt.Errorf("emergency access has expired")
+	}
+
+	repKey, rep, err := getReport(ctx, ea.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	resultJSON, err := readReportResult(ctx, repKey, *rep)
+	if err != nil {
+		return nil, err
+	}
+	content := toReportContent(*rep, resultJSON)
+	return &content, nil
+}
+
+// 病患（或持有 READ 代理授權者）列出報告的緊急存取紀錄，filter.Status 可為 ACKNOWLEDGED / UNACKNOWLEDGED
+func (h *HealthCheckContract) ListEmergencyAccesses(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*EmergencyAccessPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.ClinicID != "" {
+		return nil, fmt.Errorf("clinic filter is not supported for emergency accesses")
+	}
+
+	selector := map[string]interface{}{
+		"docType":     docEmergencyAccess,
+		"patientHash": patientHash,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["accessedAt"] = r
+	}
+	switch filter.Status {
+	case "":
+	case "ACKNOWLEDGED":
+		selector["acknowledged"] = true
+	case "UNACKNOWLEDGED":
+		selector["acknowledged"] = false
+	default:
+		return nil, fmt.Errorf("invalid status filter: %s", filter.Status)
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &EmergencyAccessPage{Records: []EmergencyAccess{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var ea EmergencyAccess
+		if err := json.Unmarshal(v, &ea); err != nil {
+			continue
+		}
+		page.Records = append(page.Records, ea)
+	}
+	return page, nil
+}
+
+// 病患（或持有 APPROVE 代理授權者）確認緊急存取紀錄，只能確認該病患報告的紀錄；patientHash 為空代表本人
+func (h *HealthCheckContract) AcknowledgeEmergencyAccess(ctx contractapi.TransactionContextInterface, accessID, patientHash string) error {
+	patientHash, actorHash, err := actingPatient(ctx, patientHash, delegateScopeApprove)
+	if err != nil {
+		return err
+	}
+	key, ea, err := getEmergencyAccess(ctx, accessID)
+	if err != nil {
+		return err
+	}
+	if ea.PatientHash != patientHash {
+		return fmt.Errorf("not authorized to acknowledge this emergency access")
+	}
+	if ea.Acknowledged {
+		return fmt.Errorf("emergency access already acknowledged")
+	}
+	ea.Acknowledged = true
+	ea.AcknowledgedAt = txTime(ctx)
+	ea.AcknowledgedBy = actorHash
+	ea.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(ea)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update emergency access")
+	}
+	return nil
+}
@@ -5,57 +5,93 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hyperledger/fabric-chaincode-go/pkg/cid"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 )
 
 const (
-	docHealth = "HealthRecord"
-	docAuth   = "AuthTicket"
-	docAccessRequest = "AccessRequest"
-	keyReportNS = "REPORT"
-	keyAuthNS   = "AUTH"
-	keyAccessRequestNS = "ACCESS_REQUEST"
+	docHealth            = "HealthRecord"
+	docAuth              = "AuthTicket"
+	docAccessRequest     = "AccessRequest"
+	keyReportNS          = "REPORT"
+	keyAuthNS            = "AUTH"
+	keyAccessRequestNS   = "ACCESS_REQUEST"
+	docSystemConfig      = "SystemConfig"
+	keyConfigNS          = "CONFIG"
+	docClinic            = "Clinic"
+	keyClinicNS          = "CLINIC"
+	docInsurer           = "Insurer"
+	keyInsurerNS         = "INSURER"
+	docEmergencyAccess   = "EmergencyAccess"
+	keyEmergencyAccessNS = "EMERGENCY_ACCESS"
+	docDelegation        = "Delegation"
+	keyDelegationNS      = "DELEGATION"
+	docConsentPolicy     = "ConsentPolicy"
+	keyConsentPolicyNS   = "CONSENT_POLICY"
+	docRequesterBlock    = "RequesterBlock"
+	keyRequesterBlockNS  = "REQUESTER_BLOCK"
+	docRequestLimit      = "RequestLimit"
+	keyRequestLimitNS    = "REQUEST_LIMIT"
+	docReadReceipt       = "ReadReceipt"
+	keyReadReceiptNS     = "READ_RECEIPT"
+	keyPendingRequestNS  = "PENDING_REQUEST" // 待處理授權請求的索引，值為空
+	keyReportRequestNS   = "REPORT_REQUEST"  // 報告的授權請求索引，值為空
+
+	// 報告內容存放於私有資料集合，world state 只保留雜湊（見 collections_config.json）
+	collectionReportResults = "healthReportResults"
+	transientResultKey      = "resultJson"
+	// 更正前的報告內容以 REPORT_VERSION~reportID~版本號 保留於同一私有資料集合
+	keyReportVersionNS = "REPORT_VERSION"
+
+	// 授權模式：空值為完整（或欄位限定）報告，PREDICATE 只允許評估條件並回傳布林結果
+	accessModePredicate = "PREDICATE"
+
+	// 授權請求類型：空值為新的授權，EXTEND 為延長既有票據的期限
+	requestTypeExtend = "EXTEND"
+
+	// 代理授權範圍：APPROVE 可處理授權請求與撤銷票據，READ 可讀取報告，ALL 兩者皆可
+	delegateScopeApprove = "APPROVE"
+	delegateScopeRead    = "READ"
+	delegateScopeAll     = "ALL"
+
+	// 病患回應授權請求的預設期限（秒），可由 system 身份透過 SetRequestResponseWindow 調整
+	defaultResponseWindow int64 = 7 * 24 * 60 * 60
+
+	// 同一保險業者對同一病患可同時待處理的授權請求數，以及被拒絕後不可再次申請的冷卻期間（秒），
+	// 可由 system 身份透過 SetRequestLimits 調整
+	defaultMaxPendingRequests int32 = 5
+	defaultRejectionCooldown  int64 = 7 * 24 * 60 * 60
+
+	// 緊急存取紀錄寫入後，emergency 身份可讀取報告內容的時間（秒）。每筆紀錄只涵蓋緊接在後的一次讀取，
+	// 再次讀取必須重新呼叫 BreakGlassRead 寫入新紀錄
+	breakGlassReadWindow int64 = 60
+
+	// 讀取收據寫入後，保險業者可憑收據讀取報告內容的時間（秒）
+	readReceiptWindow int64 = 10 * 60
+
+	// 報告內容格式：1 為舊的自由格式字串（如 "95 mg/dL"），2 為結構化檢驗結果（見 LabResult）
+	schemaVersionLegacy    = 1
+	schemaVersionLabResult = 2
+
+	// 假名遷移的新舊假名與要改寫的鍵值只經由 transient data 傳遞，不寫入區塊
+	transientOldPseudonym = "oldPseudonym"
+	transientNewPseudonym = "newPseudonym"
+	transientRecordKeys   = "recordKeys"
+
+	// 列表查詢分頁大小
+	defaultPageSize int32 = 20
+	maxPageSize     int32 = 100
 )
 
-type HealthReport struct {
-	DocType     string `json:"docType"`
-	ReportID    string `json:"reportId"`
-	PatientHash string `json:"patientHash"`
-	ClinicID    string `json:"clinicId"`
-	ResultJSON  string `json:"resultJson"`
-	CreatedAt   int64  `json:"createdAt"`
-}
-
-type AuthTicket struct {
-	DocType      string `json:"docType"`
-	PatientHash  string `json:"patientHash"`
-	TargetHash   string `json:"targetHash"`
-	ReportID     string `json:"reportId"`
-	GrantedAt    int64  `json:"grantedAt"`
-	Expiry       int64  `json:"expiry"`
-}
-
-type AccessRequest struct {
-	DocType      string `json:"docType"`
-	RequestID    string `json:"requestId"`
-	ReportID     string `json:"reportId"`
-	PatientHash  string `json:"patientHash"`
-	RequesterHash string `json:"requesterHash"`
-	Reason       string `json:"reason"`
-	RequestedAt  int64  `json:"requestedAt"`
-	Expiry       int64  `json:"expiry"`
-	Status       string `json:"status"`
-}
-
-// 只包含 metadata
-type ReportMeta struct {
-	ReportID  string `json:"reportId"`
-	ClinicID  string `json:"clinicId"`
-	CreatedAt int64  `json:"createdAt"`
+// 列表查詢的篩選條件，未填的欄位不套用
+type ListFilter struct {
+	FromDate      int64  `json:"fromDate,omitempty"` // Unix 秒，含當下
+	ToDate        int64  `json:"toDate,omitempty"`   // Unix 秒，含當下
+	ClinicID      string `json:"clinicId,omitempty"`
+	Status        string `json:"status,omitempty"`
+	PatientHash   string `json:"patientHash,omitempty"`   // 代理人查詢被代理病患時指定，空值為呼叫者本人
+	RequesterHash string `json:"requesterHash,omitempty"` // 依保險業者篩選授權請求
 }
 
 type HealthCheckContract struct {
@@ -79,18 +115,45 @@ func getCaller(ctx contractapi.TransactionContextInterface) (userID, role string
 	role, ok2, _ := id.GetAttributeValue("role")
 
 	if !ok1 || !ok2 {
-		err = fmt.Errorf("missing hf.EnrollmentID or role attribute in cert")
+		return userID, role, fmt.Errorf("missing hf.EnrollmentID or role attribute in cert")
 	}
 
+	// 病患與保險業者以假名識別，舊憑證只在 system 身份開啟遷移模式時接受
+	if role == "patient" || role == "insurer" {
+		if pseudonym, ok, _ := id.GetAttributeValue("pseudonym"); !ok || pseudonym == "" {
+			_, cfg, cfgErr := getSystemConfig(ctx)
+			if cfgErr != nil || !cfg.PseudonymMigrationMode {
+				err = fmt.Errorf("missing pseudonym attribute in cert")
+			}
+		}
+	}
 	return
 }
 
+// 取得調用者的假名(internal function)：憑證帶有 pseudonym 屬性時使用伺服器以金鑰產生的 HMAC 假名；
+// 沒有該屬性的舊憑證已由 getCaller 拒絕，只有遷移模式下才會沿用 SHA-256(enrollmentID)
+func callerHash(ctx contractapi.TransactionContextInterface, userID string) string {
+	id, err := cid.New(ctx.GetStub())
+	if err == nil {
+		if pseudonym, ok, _ := id.GetAttributeValue("pseudonym"); ok && pseudonym != "" {
+			return pseudonym
+		}
+	}
+	return hashID(userID)
+}
+
 func getClinicID(ctx contractapi.TransactionContextInterface) string {
 	id, _ := cid.New(ctx.GetStub())
 	clinic, _, _ := id.GetAttributeValue("clinicId")
 	return clinic
 }
 
+// 取得調用者所屬 MSP，寫入紀錄供稽核使用
+func getMSPID(ctx contractapi.TransactionContextInterface) string {
+	msp, _ := cid.GetMSPID(ctx.GetStub())
+	return msp
+}
+
 func recPatientHash(raw []byte) string {
 	var t struct {
 		PatientHash string `json:"patientHash"`
@@ -99,564 +162,64 @@ func recPatientHash(raw []byte) string {
 	return t.PatientHash
 }
 
-func nowSec() int64 {
-	return time.Now().Unix()
-}
-
-// 上傳報告
-func (h *HealthCheckContract) UploadReport(ctx contractapi.TransactionContextInterface, reportID, patientHash, resultJSON string) error {
-	id, err := cid.New(ctx.GetStub())
-	if err != nil {
-		return fmt.Errorf("failed to get client identity")
-	}
-	role, ok, err := id.GetAttributeValue("role")
-	if err != nil || !ok || role != "clinic" {
-		return fmt.Errorf("only clinic can upload report")
-	}
-
-	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
-	b, _ := ctx.GetStub().GetState(repKey)
-	if b != nil {
-		return fmt.Errorf("reportID already exists")
-	}
-
-	rec := HealthReport{
-		DocType:     docHealth,
-		ReportID:    reportID,
-		PatientHash: patientHash,
-		ClinicID:    getClinicID(ctx),
-		ResultJSON:  resultJSON,
-		CreatedAt:   nowSec(),
+// 以交易時間戳取代節點本地時間，確保各背書節點的結果一致
+func txTime(ctx contractapi.TransactionContextInterface) int64 {
+	ts, err := ctx.GetStub().GetTxTimestamp()
+	if err != nil || ts == nil {
+		return 0
 	}
-	bytes, _ := json.Marshal(rec)
-
-
-	return ctx.GetStub().PutState(repKey, bytes)
-}
-
-func (h *HealthCheckContract) ApproveAndAuthorizeAccess(ctx contractapi.TransactionContextInterface, requestID string) error {
-    userID, role, err := getCaller(ctx)
-    if err != nil || role != "patient" {
-        return fmt.Errorf("only patient can approve")
-    }
-
-    // 1. 取得請求並驗證
-    reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{requestID})
-    reqBytes, err := ctx.GetStub().GetState(reqKey)
-    if err != nil || reqBytes == nil {
-        return fmt.Errorf("request not found")
-    }
-    var req AccessRequest
-    if err := json.Unmarshal(reqBytes, &req); err != nil {
-        return fmt.Errorf("failed to unmarshal request: %v", err)
-    }
-    patientHash := hashID(userID)
-    if req.PatientHash != patientHash {
-        return fmt.Errorf("not authorized to approve this request")
-    }
-    if req.Status != "PENDING" {
-        return fmt.Errorf("request already handled")
-    }
-
-    // 2. 更新狀態
-    req.Status = "APPROVED"
-    newReqBytes, _ := json.Marshal(req)
-    if err := ctx.GetStub().PutState(reqKey, newReqBytes); err != nil {
-        return fmt.Errorf("failed to update request status")
-    }
-
-    // 3. 產生授權票據
-    ticketKey, _ := ctx.GetStub().CreateCompositeKey(keyAuthNS, []string{req.PatientHash, req.RequesterHash, req.ReportID})
-    tk := AuthTicket{
-        DocType:     docAuth,
-        PatientHash: req.PatientHash,
-        TargetHash:  req.RequesterHash,
-        ReportID:    req.ReportID,
-        GrantedAt:   nowSec(),
-        Expiry:      req.Expiry,
-    }
-    tbytes, _ := json.Marshal(tk)
-	if err := ctx.GetStub().PutState(ticketKey, tbytes); err != nil {
-        return fmt.Errorf("failed to store auth ticket")
-    }
-
-	eventPayload, _ := json.Marshal(map[string]interface{}{
-        "requestId":    req.RequestID,
-        "reportId":     req.ReportID,
-        "patientHash":  req.PatientHash,
-        "requesterHash": req.RequesterHash,
-        "status":       "APPROVED",
-        "grantedAt":    nowSec(),
-        "expiry":       req.Expiry,
-    })
-    if err := ctx.GetStub().SetEvent("AccessApproved", eventPayload); err != nil {
-        return fmt.Errorf("failed to set event")
-    }
-
-    return nil
+	return ts.GetSeconds()
 }
 
-// RejectAccessRequest 病患拒絕授權請求
-func (h *HealthCheckContract) RejectAccessRequest(ctx contractapi.TransactionContextInterface, requestID string) error {
-    userID, role, err := getCaller(ctx)
-    if err != nil || role != "patient" {
-        return fmt.Errorf("only patient can reject access request")
-    }
-
-    // 1. 取得請求並驗證
-    reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{requestID})
-    reqBytes, err := ctx.GetStub().GetState(reqKey)
-    if err != nil || reqBytes == nil {
-        return fmt.Errorf("request not found")
-    }
-    
-    var req AccessRequest
-    if err := json.Unmarshal(reqBytes, &req); err != nil {
-        return fmt.Errorf("failed to unmarshal request: %v", err)
-    }
-    
-    patientHash := hashID(userID)
-    if req.PatientHash != patientHash {
-        return fmt.Errorf("not authorized to reject this request")
-    }
-    
-    if req.Status != "PENDING" {
-        return fmt.Errorf("request already handled")
-    }
-
-    // 2. 更新狀態為拒絕
-    req.Status = "REJECTED"
-    newReqBytes, _ := json.Marshal(req)
-    if err := ctx.GetStub().PutState(reqKey, newReqBytes); err != nil {
-        return fmt.Errorf("failed to update request status")
-    }
-
-    // 3. 發送拒絕事件
-    eventPayload, _ := json.Marshal(map[string]interface{}{
-        "requestId":    req.RequestID,
-        "reportId":     req.ReportID,
-        "patientHash":  req.PatientHash,
-        "requesterHash": req.RequesterHash,
-        "status":       "REJECTED",
-        "rejectedAt":   nowSec(),
-    })
-    if err := ctx.GetStub().SetEvent("AccessRejected", eventPayload); err != nil {
-        return fmt.Errorf("failed to set event")
-    }
-
-    return nil
-}
-
-
-/**
- * @notice 查詢自己的所有健檢報告，只回傳 metadata，不含內容
- * @dev 只允許 patient 身份，回傳該 patient 的所有報告 meta 資訊
- * @param ctx Fabric合約上下文
- * @return []ReportMeta 報告meta陣列, error 查詢失敗或權限錯誤
- */
-func (h *HealthCheckContract) ListMyReportMeta(ctx contractapi.TransactionContextInterface) ([]ReportMeta, error) {
-	userID, role, err := getCaller(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get caller identity: %v", err)
-	}
-	if role != "patient" {
-		return nil, fmt.Errorf("only patient can list their report meta")
-	}
-	patientHash := hashID(userID)
-
-	query := fmt.Sprintf(`{
-		"selector": {
-			"docType": "%s",
-			"patientHash": "%s"
-		}
-	}`, docHealth, patientHash)
-
-	iter, err := ctx.GetStub().GetQueryResult(query)
-	if err != nil {
-		return nil, fmt.Errorf("query execution failed: %v", err)
-	}
-	defer iter.Close()
-
-	var results []ReportMeta
-	for iter.HasNext() {
-		kv, err := iter.Next()
-		if err != nil {
-			continue
-		}
-		var report HealthReport
-		if err := json.Unmarshal(kv.Value, &report); err != nil {
-			continue
-		}
-		results = append(results, ReportMeta{
-			ReportID:  report.ReportID,
-			ClinicID:  report.ClinicID,
-			CreatedAt: report.CreatedAt,
-		})
-	}
-	return results, nil
-}
-
-/**
- * @notice 病患讀取自己的報告詳細內容（需先取得 meta 再查詢內容）
- * @dev 只允許 patient 身份，只能讀自己擁有的報告
- * @param ctx Fabric合約上下文
- * @param reportID 報告ID
- * @return string 報告內容 JSON, error 查詢失敗或無權限
- */
-func (h *HealthCheckContract) ReadMyReport(ctx contractapi.TransactionContextInterface, reportID string) (string, error) {
-	userID, role, err := getCaller(ctx)
-	if err != nil || role != "patient" {
-		return "", fmt.Errorf("only patient can read own report")
-	}
-	patientHash := hashID(userID)
-	// 查詢報告內容
-	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
-	data, err := ctx.GetStub().GetState(repKey)
-	if err != nil || data == nil {
-		return "", fmt.Errorf("report not found")
-	}
-	var rep HealthReport
-	if err := json.Unmarshal(data, &rep); err != nil {
-		return "", fmt.Errorf("failed to parse report")
-	}
-	if rep.PatientHash != patientHash {
-		return "", fmt.Errorf("not authorized to read this report")
-	}
-	return rep.ResultJSON, nil
-}
-
-
-/**
- * @notice 查詢特定病患的所有健檢報告 meta，只回傳 meta，不含內容
- * @dev 只允許 insurer 身份，需傳入病患ID
- * @param ctx Fabric合約上下文
- * @param patientID 病患身份證或ID
- * @return []ReportMeta 報告meta陣列, error 查詢失敗或權限錯誤
- */
-func (h *HealthCheckContract) ListReportMetaByPatientID(ctx contractapi.TransactionContextInterface, patientID string) ([]ReportMeta, error) {
-	_, role, err := getCaller(ctx)
-	if err != nil || role != "insurer" {
-		return nil, fmt.Errorf("only insurer can query report meta")
-	}
-	patientHash := hashID(patientID)
-
-	query := fmt.Sprintf(`{
-		"selector": {
-			"docType": "%s",
-			"patientHash": "%s"
-		}
-	}`, docHealth, patientHash)
-
-	iter, err := ctx.GetStub().GetQueryResult(query)
-	if err != nil {
-		return nil, fmt.Errorf("query execution failed: %v", err)
-	}
-	defer iter.Close()
-
-	var results []ReportMeta
-	for iter.HasNext() {
-		kv, err := iter.Next()
-		if err != nil {
-			continue
-		}
-		var report HealthReport
-		if err := json.Unmarshal(kv.Value, &report); err != nil {
-			continue
-		}
-		results = append(results, ReportMeta{
-			ReportID:  report.ReportID,
-			ClinicID:  report.ClinicID,
-			CreatedAt: report.CreatedAt,
-		})
-	}
-	return results, nil
-}
-
-/**
- * @notice 保險業者讀取已授權的健檢報告內容
- * @dev 只允許 insurer 身份，且必須獲得授權票據，且票據未過期
- * @param ctx Fabric合約上下文
- * @param patientHash 病患hash
- * @param reportID 報告ID
- * @return string 報告內容 JSON, error 查詢失敗或無權限
- */
-func (h *HealthCheckContract) ReadAuthorizedReport(ctx contractapi.TransactionContextInterface, patientHash, reportID string) (string, error) {
-	userID, role, err := getCaller(ctx)
-	if err != nil || role != "insurer" {
-		return "", fmt.Errorf("only insurer can read report")
-	}
-	targetHash := hashID(userID)
-
-	// 查詢授權票
-	iter, err := ctx.GetStub().GetStateByPartialCompositeKey(keyAuthNS, []string{patientHash, targetHash, reportID})
-	if err != nil {
-		return "", fmt.Errorf("error accessing authorization ticket")
-	}
-	defer iter.Close()
-
-	found := false
-	expired := false
-	var tk AuthTicket
-	now := nowSec()
-	for iter.HasNext() {
-		kv, _ := iter.Next()
-		if err := json.Unmarshal(kv.Value, &tk); err != nil {
-			continue
-		}
-		if tk.ReportID == reportID {
-			if now <= tk.Expiry {
-				found = true
-				break
-			} else {
-				expired = true
-			}
-		}
-	}
-	if !found {
-		if expired {
-			return "", fmt.Errorf("access expired for report %s", reportID)
-		}
-		return "", fmt.Errorf("access denied for report %s", reportID)
+// 依篩選條件產生時間區間 selector(internal function)
+func dateRange(filter ListFilter) map[string]interface{} {
+	if filter.FromDate == 0 && filter.ToDate == 0 {
+		return nil
 	}
-
-	// 查詢報告內容
-	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
-	data, err := ctx.GetStub().GetState(repKey)
-	if err != nil || data == nil {
-		return "", fmt.Errorf("report not found")
+	r := map[string]interface{}{}
+	if filter.FromDate > 0 {
+		r["$gte"] = filter.FromDate
 	}
-	var rep HealthReport
-	if err := json.Unmarshal(data, &rep); err != nil {
-		return "", fmt.Errorf("failed to parse report")
+	if filter.ToDate > 0 {
+		r["$lte"] = filter.ToDate
 	}
-	return rep.ResultJSON, nil
+	return r
 }
 
-
-func (h *HealthCheckContract) ListMyAuthorizedTickets(ctx contractapi.TransactionContextInterface) ([]AuthTicket, error) {
-	userID, role, err := getCaller(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get caller identity: %v", err)
-	}
-	if role != "patient" {
-		return nil, fmt.Errorf("only patient can list their authorized reports")
+// 以 CouchDB 分頁查詢(internal function)，回傳該頁資料與下一頁 bookmark
+func queryPage(ctx contractapi.TransactionContextInterface, selector map[string]interface{}, pageSize int32, bookmark string) ([][]byte, string, int32, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
 	}
-
-	patientHash := hashID(userID)
-
-	query := fmt.Sprintf(`{
-		"selector": {
-			"docType": "%s",
-			"patientHash": "%s"
-		}
-	}`, docAuth, patientHash)
-	
-	iter, err := ctx.GetStub().GetQueryResult(query)
-	if err != nil {
-		return nil, fmt.Errorf("query execution failed: %v", err)
-	}
-	defer iter.Close()
-
-	var results []AuthTicket
-	for iter.HasNext() {
-		kv, err := iter.Next()
-		if err != nil {
-			continue
-		}
-		var tk AuthTicket
-		if err := json.Unmarshal(kv.Value, &tk); err != nil {
-			continue
-		}
-		results = append(results, tk)
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
 	}
-	return results, nil
-}
-	
-// 保險業者查看所有已授權的報告
-func (h *HealthCheckContract) ListAuthorizedReports(ctx contractapi.TransactionContextInterface) ([]map[string]interface{}, error) {
-	userID, role, err := getCaller(ctx)
+	query, err := json.Marshal(map[string]interface{}{"selector": selector})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+		return nil, "", 0, fmt.Errorf("failed to build query: %v", err)
 	}
-	if role != "insurer" {
-		return nil, fmt.Errorf("only insurer can list authorized reports")
-	}
-
-	targetHash := hashID(userID)
-
-	query := fmt.Sprintf(`{
-		"selector": {
-			"docType": "%s",
-			"targetHash": "%s"
-		}
-	}`, docAuth, targetHash)
-
-	iter, err := ctx.GetStub().GetQueryResult(query)
+	iter, meta, err := ctx.GetStub().GetQueryResultWithPagination(string(query), pageSize, bookmark)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query authorization tickets: %v", err)
+		return nil, "", 0, fmt.Errorf("query execution failed: %v", err)
 	}
 	defer iter.Close()
 
-	now := nowSec()
-	var results []map[string]interface{}
-
+	var values [][]byte
 	for iter.HasNext() {
 		kv, err := iter.Next()
 		if err != nil {
 			continue
 		}
-
-		var tk AuthTicket
-		if err := json.Unmarshal(kv.Value, &tk); err != nil {
-			continue
-		}
-		if now > tk.Expiry {
-			continue // 忽略過期授權
-		}
-
-		// 查詢對應報告
-		repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{tk.ReportID})
-		rb, err := ctx.GetStub().GetState(repKey)
-		if err != nil || rb == nil {
-			continue
-		}
-
-		var rep HealthReport
-		if err := json.Unmarshal(rb, &rep); err != nil {
-			continue
-		}
-
-		// 組合報告和授權信息
-		result := map[string]interface{}{
-			"reportId":    rep.ReportID,
-			"clinicId":    rep.ClinicID,
-			"patientHash": rep.PatientHash,
-			"resultJson":  rep.ResultJSON,
-			"createdAt":   rep.CreatedAt,
-			"expiry":      tk.Expiry,
-		}
-		results = append(results, result)
-	}
-
-	return results, nil
-}
-
-
-
-// 請求授權
-func (h *HealthCheckContract) RequestAccess(ctx contractapi.TransactionContextInterface, reportID, patientHash, reason, expiryStr string) error {
-	userID, role, err := getCaller(ctx)
-	if err != nil || role != "insurer" {
-		return fmt.Errorf("only insurer can request access")
+		values = append(values, kv.Value)
 	}
-
-	requesterHash := hashID(userID)
-	requestID := fmt.Sprintf("req_%d", nowSec())
-
-	// 檢查報告是否存在
-	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
-	rb, _ := ctx.GetStub().GetState(repKey)
-	if rb == nil {
-		return fmt.Errorf("report not found")
-	}
-
-	expiry, errExp := strconv.ParseInt(expiryStr, 10, 64)
-	if errExp != nil || expiry <= nowSec() {
-		return fmt.Errorf("invalid expiry")
-	}
-
-	reqKey, _ := ctx.GetStub().CreateCompositeKey(keyAccessRequestNS, []string{requestID})
-	req := AccessRequest{
-		DocType:       docAccessRequest,
-		RequestID:     requestID,
-		ReportID:      reportID,
-		PatientHash:   patientHash,
-		RequesterHash: requesterHash,
-		Reason:        reason,
-		RequestedAt:   nowSec(),
-		Expiry:        expiry,
-		Status:        "PENDING",
+	// 最後一頁時清空 bookmark，讓呼叫端知道沒有更多資料
+	next := meta.GetBookmark()
+	if meta.GetFetchedRecordsCount() < pageSize {
+		next = ""
 	}
-
-	reqBytes, _ := json.Marshal(req)
-	return ctx.GetStub().PutState(reqKey, reqBytes)
+	return values, next, meta.GetFetchedRecordsCount(), nil
 }
 
-// 列出待處理的授權請求
-func (h *HealthCheckContract) ListPendingAccessRequests(ctx contractapi.TransactionContextInterface) ([]AccessRequest, error) {
-	userID, role, err := getCaller(ctx)
-	if err != nil || role != "patient" {
-		return nil, fmt.Errorf("only patient can list pending requests")
-	}
-
-	patientHash := hashID(userID)
-	query := fmt.Sprintf(`{
-		"selector": {
-			"docType": "%s",
-			"patientHash": "%s",
-			"status": "PENDING"
-		}
-	}`, docAccessRequest, patientHash)
-
-	iter, err := ctx.GetStub().GetQueryResult(query)
-	if err != nil {
-		return nil, fmt.Errorf("query execution failed: %v", err)
-	}
-	defer iter.Close()
-
-	var results []AccessRequest
-	for iter.HasNext() {
-		kv, err := iter.Next()
-		if err != nil {
-			continue
-		}
-
-		var req AccessRequest
-		if err := json.Unmarshal(kv.Value, &req); err != nil {
-			continue
-		}
-		results = append(results, req)
-	}
-
-	return results, nil
-}
-// 列出保險業者發出的授權請求
-func (h *HealthCheckContract) ListMyAccessRequests(ctx contractapi.TransactionContextInterface) ([]AccessRequest, error) {
-    userID, role, err := getCaller(ctx)
-    if err != nil || role != "insurer" {
-        return nil, fmt.Errorf("only insurer can list their access requests")
-    }
-    requesterHash := hashID(userID)
-
-    query := fmt.Sprintf(`{
-        "selector": {
-            "docType": "%s",
-            "requesterHash": "%s"
-        }
-    }`, docAccessRequest, requesterHash)
-
-    iter, err := ctx.GetStub().GetQueryResult(query)
-    if err != nil {
-        return nil, fmt.Errorf("query execution failed: %v", err)
-    }
-    defer iter.Close()
-
-    var results []AccessRequest
-    for iter.HasNext() {
-        kv, err := iter.Next()
-        if err != nil {
-            continue
-        }
-        var req AccessRequest
-        if err := json.Unmarshal(kv.Value, &req); err != nil {
-            continue
-        }
-        results = append(results, req)
-    }
-    return results, nil
-}
-
-
 func main() {
 	chaincode, err := contractapi.NewChaincode(&HealthCheckContract{})
 	if err != nil {
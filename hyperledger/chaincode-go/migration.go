@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// MigratePseudonym 單次呼叫的結果：Scanned 為收到的紀錄數（含無法辨識而略過者），Migrated 為實際改寫的筆數
+type PseudonymMigrationBatch struct {
+	Scanned  int `json:"scanned"`
+	Migrated int `json:"migrated"`
+}
+
+// 檢查是否為 SHA-256 / HMAC-SHA256 的十六進位字串(internal function)
+func isHexHash(h string) bool {
+	if len(h) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(h)
+	return err == nil
+}
+
+// 從 transient data 取得遷移的新舊假名(internal function)。對應關係不可出現在交易參數、事件或 world state，
+// 否則任何能讀取帳本的人都能由舊的 SHA-256 假名追到新假名
+func getTransientPseudonyms(ctx contractapi.TransactionContextInterface) (oldHash, newHash string, transient map[string][]byte, err error) {
+	transient, err = ctx.GetStub().GetTransient()
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to get transient data: %v", err)
+	}
+	oldHash = string(transient[transientOldPseudonym])
+	newHash = string(transient[transientNewPseudonym])
+	if !isHexHash(oldHash) || (newHash != "" && (!isHexHash(newHash) || oldHash == newHash)) {
+		return "", "", nil, fmt.Errorf("invalid pseudonym pair")
+	}
+	return oldHash, newHash, transient, nil
+}
+
+/**
+ * @notice 查詢仍帶有舊假名的紀錄鍵值，供遷移工具分批交給 MigratePseudonym
+ * @dev 只允許 system 身份，僅供 evaluate；舊假名由 transient data 的 oldPseudonym 提供
+ * @param ctx Fabric合約上下文
+ * @param limit 最多回傳的筆數，0 使用預設分頁大小
+ * @return []string 紀錄的 world state 鍵值, error 權限或查詢失敗
+ */
+func (h *HealthCheckContract) ListPseudonymRecords(ctx contractapi.TransactionContextInterface, limit int32) ([]string, error) {
+	if err := requireSystem(ctx); err != nil {
+		return nil, err
+	}
+	oldHash, _, _, err := getTransientPseudonyms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	query, _ := json.Marshal(map[string]interface{}{
+		"selector": map[string]interface{}{
+			"$or": []interface{}{
+				map[string]interface{}{"patientHash": oldHash},
+				map[string]interface{}{"targetHash": oldHash},
+				map[string]interface{}{"requesterHash": oldHash},
+				map[string]interface{}{"insurerHash": oldHash},
+				map[string]interface{}{"delegateHash": oldHash},
+				map[string]interface{}{"decidedBy": oldHash},
+				map[string]interface{}{"grantedBy": oldHash},
+				map[string]interface{}{"revokedBy": oldHash},
+				map[string]interface{}{"readerHash": oldHash},
+				map[string]interface{}{"blockedBy": oldHash},
+				map[string]interface{}{"unblockedBy": oldHash},
+				map[string]interface{}{"acknowledgedBy": oldHash},
+				map[string]interface{}{"createdBy": oldHash},
+				map[string]interface{}{"updatedBy": oldHash},
+			},
+		},
+		"limit": limit,
+	})
+	iter, err := ctx.GetStub().GetQueryResult(string(query))
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %v", err)
+	}
+	defer iter.Close()
+
+	keys := []string{}
+	for iter.HasNext() && int32(len(keys)) < limit {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		keys = append(keys, kv.Key)
+	}
+	return keys, nil
+}
+
+/**
+ * @notice 將舊假名（SHA-256）改寫為新的 HMAC 假名
+ * @dev 只允許 system 身份；新舊假名（oldPseudonym、newPseudonym）與要改寫的鍵值（recordKeys，JSON 陣列，來自 ListPseudonymRecords）
+ *      皆由 transient data 提供，不寫入區塊，也不留下遷移紀錄或事件。逐筆以 GetState 重新讀取，
+ *      改寫 HealthReport、AuthTicket（含複合鍵）、AccessRequest、EmergencyAccess、ConsentPolicy（含複合鍵）、Delegation（含複合鍵）與 Insurer（含複合鍵）中等於舊假名的欄位；
+ *      不含舊假名或無法辨識的紀錄會略過且不改寫
+ * @param ctx Fabric合約上下文
+ * @return *PseudonymMigrationBatch 本次收到與改寫的筆數, error 權限或寫入失敗
+ */
+func (h *HealthCheckContract) MigratePseudonym(ctx contractapi.TransactionContextInterface) (*PseudonymMigrationBatch, error) {
+	if err := requireSystem(ctx); err != nil {
+		return nil, err
+	}
+	oldHash, newHash, transient, err := getTransientPseudonyms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if newHash == "" {
+		return nil, fmt.Errorf("%s must be provided in transient data", transientNewPseudonym)
+	}
+	var keys []string
+	if err := json.Unmarshal(transient[transientRecordKeys], &keys); err != nil {
+		return nil, fmt.Errorf("%s must be a JSON array in transient data", transientRecordKeys)
+	}
+	if int32(len(keys)) > maxPageSize {
+		return nil, fmt.Errorf("at most %d records per call", maxPageSize)
+	}
+
+	swap := func(v string) string {
+		if v == oldHash {
+			return newHash
+		}
+		return v
+	}
+
+	batch := &PseudonymMigrationBatch{}
+	for _, recordKey := range keys {
+		batch.Scanned++
+		data, err := ctx.GetStub().GetState(recordKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", recordKey, err)
+		}
+		if data == nil || !bytes.Contains(data, []byte(oldHash)) {
+			continue
+		}
+		var doc struct {
+			DocType string `json:"docType"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			continue
+		}
+
+		key := recordKey
+		var value interface{}
+		switch doc.DocType {
+		case docHealth:
+			var rep HealthReport
+			if err := json.Unmarshal(data, &rep); err != nil {
+				continue
+			}
+			rep.PatientHash = swap(rep.PatientHash)
+			value = rep
+		case docAuth:
+			var tk AuthTicket
+			if err := json.Unmarshal(data, &tk); err != nil {
+				continue
+			}
+			tk.PatientHash = swap(tk.PatientHash)
+			tk.TargetHash = swap(tk.TargetHash)
+			tk.GrantedBy = swap(tk.GrantedBy)
+			tk.RevokedBy = swap(tk.RevokedBy)
+			// 票據的複合鍵包含假名，需刪除舊鍵後以新鍵寫入
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete ticket %s", recordKey)
+			}
+			key, _ = ctx.GetStub().CreateCompositeKey(keyAuthNS, []string{tk.PatientHash, tk.TargetHash, tk.ReportID})
+			value = tk
+		case docAccessRequest:
+			var req AccessRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				continue
+			}
+			req.PatientHash = swap(req.PatientHash)
+			req.RequesterHash = swap(req.RequesterHash)
+			req.DecidedBy = swap(req.DecidedBy)
+			value = req
+		case docConsentPolicy:
+			var p ConsentPolicy
+			if err := json.Unmarshal(data, &p); err != nil {
+				continue
+			}
+			p.PatientHash = swap(p.PatientHash)
+			p.InsurerHash = swap(p.InsurerHash)
+			p.CreatedBy = swap(p.CreatedBy)
+			p.UpdatedBy = swap(p.UpdatedBy)
+			p.RevokedBy = swap(p.RevokedBy)
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete consent policy %s", recordKey)
+			}
+			if key, err = consentPolicyKey(ctx, p); err != nil {
+				return nil, err
+			}
+			value = p
+		case docDelegation:
+			var d Delegation
+			if err := json.Unmarshal(data, &d); err != nil {
+				continue
+			}
+			d.PatientHash = swap(d.PatientHash)
+			d.DelegateHash = swap(d.DelegateHash)
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete delegation %s", recordKey)
+			}
+			key, _ = ctx.GetStub().CreateCompositeKey(keyDelegationNS, []string{d.PatientHash, d.DelegateHash})
+			value = d
+		case docRequesterBlock:
+			var b RequesterBlock
+			if err := json.Unmarshal(data, &b); err != nil {
+				continue
+			}
+			b.PatientHash = swap(b.PatientHash)
+			b.RequesterHash = swap(b.RequesterHash)
+			b.BlockedBy = swap(b.BlockedBy)
+			b.UnblockedBy = swap(b.UnblockedBy)
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete requester block %s", recordKey)
+			}
+			key, _ = ctx.GetStub().CreateCompositeKey(keyRequesterBlockNS, []string{b.PatientHash, b.RequesterHash})
+			value = b
+		case docRequestLimit:
+			var st RequestLimitState
+			if err := json.Unmarshal(data, &st); err != nil {
+				continue
+			}
+			st.PatientHash = swap(st.PatientHash)
+			st.RequesterHash = swap(st.RequesterHash)
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete request limit state %s", recordKey)
+			}
+			key, _ = ctx.GetStub().CreateCompositeKey(keyRequestLimitNS, []string{st.PatientHash, st.RequesterHash})
+			value = st
+		case docReadReceipt:
+			var rr ReadReceipt
+			if err := json.Unmarshal(data, &rr); err != nil {
+				continue
+			}
+			rr.PatientHash = swap(rr.PatientHash)
+			rr.ReaderHash = swap(rr.ReaderHash)
+			value = rr
+		case docEmergencyAccess:
+			var ea EmergencyAccess
+			if err := json.Unmarshal(data, &ea); err != nil {
+				continue
+			}
+			ea.PatientHash = swap(ea.PatientHash)
+			ea.AcknowledgedBy = swap(ea.AcknowledgedBy)
+			value = ea
+		case docInsurer:
+			var insurer Insurer
+			if err := json.Unmarshal(data, &insurer); err != nil {
+				continue
+			}
+			if err := ctx.GetStub().DelState(recordKey); err != nil {
+				return nil, fmt.Errorf("failed to delete insurer %s", recordKey)
+			}
+			insurer.InsurerHash = newHash
+			key, _ = ctx.GetStub().CreateCompositeKey(keyInsurerNS, []string{newHash})
+			value = insurer
+		default:
+			continue
+		}
+		rewritten, _ := json.Marshal(value)
+		if err := ctx.GetStub().PutState(key, rewritten); err != nil {
+			return nil, fmt.Errorf("failed to rewrite %s", key)
+		}
+		batch.Migrated++
+	}
+	return batch, nil
+}
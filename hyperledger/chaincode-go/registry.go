@@ -0,0 +1,372 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// 鏈上系統設定，只有 system 身份可以修改
+type SystemConfig struct {
+	DocType               string `json:"docType"`
+	RequestResponseWindow int64  `json:"requestResponseWindow"` // 秒
+	MaxPendingRequests    int32  `json:"maxPendingRequests"`    // 每組保險業者與病患
+	RejectionCooldown     int64  `json:"rejectionCooldown"`     // 秒
+	// 遷移期間允許尚未帶 pseudonym 屬性的病患與保險業者憑證以 SHA-256(enrollmentID) 呼叫，預設關閉
+	PseudonymMigrationMode bool   `json:"pseudonymMigrationMode,omitempty"`
+	UpdatedAt              int64  `json:"updatedAt,omitempty"`
+	WriterMSP              string `json:"writerMsp,omitempty"`
+}
+
+// 健檢中心登錄資料，由 admin 身份維護
+type Clinic struct {
+	DocType         string `json:"docType"`
+	ClinicID        string `json:"clinicId"`
+	Name            string `json:"name"`
+	LicenseNumber   string `json:"licenseNumber"`
+	AccreditedFrom  int64  `json:"accreditedFrom"`
+	AccreditedUntil int64  `json:"accreditedUntil"`
+	Status          string `json:"status"` // ACTIVE / SUSPENDED
+	SuspendReason   string `json:"suspendReason,omitempty"`
+	UpdatedAt       int64  `json:"updatedAt"`
+	WriterMSP       string `json:"writerMsp,omitempty"`
+}
+
+// 保險業者登錄資料，以保險業者假名為 key，由 admin 身份維護
+type Insurer struct {
+	DocType       string `json:"docType"`
+	InsurerHash   string `json:"insurerHash"`
+	LegalName     string `json:"legalName"`
+	LicenseNumber string `json:"licenseNumber"`
+	Status        string `json:"status"` // ACTIVE / SUSPENDED
+	SuspendReason string `json:"suspendReason,omitempty"`
+	UpdatedAt     int64  `json:"updatedAt"`
+	WriterMSP     string `json:"writerMsp,omitempty"`
+}
+
+// 讀取系統設定(internal function)，尚未設定時使用預設值
+func getSystemConfig(ctx contractapi.TransactionContextInterface) (string, *SystemConfig, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyConfigNS, []string{"SYSTEM"})
+	cfg := &SystemConfig{DocType: docSystemConfig}
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read system config: %v", err)
+	}
+	if data != nil {
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return "", nil, fmt.Errorf("failed to unmarshal system config: %v", err)
+		}
+	}
+	if cfg.RequestResponseWindow <= 0 {
+		cfg.RequestResponseWindow = defaultResponseWindow
+	}
+	if cfg.MaxPendingRequests <= 0 {
+		cfg.MaxPendingRequests = defaultMaxPendingRequests
+	}
+	if cfg.RejectionCooldown <= 0 {
+		cfg.RejectionCooldown = defaultRejectionCooldown
+	}
+	return key, cfg, nil
+}
+
+// 檢查呼叫者是否為平台的 system 身份(internal function)
+func requireSystem(ctx contractapi.TransactionContextInterface) error {
+	_, role, err := getCaller(ctx)
+	if err != nil || role != "system" {
+		return fmt.Errorf("only system identity can perform this operation")
+	}
+	return nil
+}
+
+// 檢查呼叫者是否為平台管理者(internal function)，負責維護健檢中心與保險業者登錄資料
+func requireAdmin(ctx contractapi.TransactionContextInterface) error {
+	_, role, err := getCaller(ctx)
+	if err != nil || role != "admin" {
+		return fmt.Errorf("only admin can perform this operation")
+	}
+	return nil
+}
+
+// 讀取健檢中心登錄資料(internal function)
+func getClinic(ctx contractapi.TransactionContextInterface, clinicID string) (string, *Clinic, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyClinicNS, []string{clinicID})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read clinic: %v", err)
+	}
+	if data == nil {
+		return key, nil, fmt.Errorf("clinic %s is not registered", clinicID)
+	}
+	var clinic Clinic
+	if err := json.Unmarshal(data, &clinic); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal clinic: %v", err)
+	}
+	return key, &clinic, nil
+}
+
+// 確認呼叫者為已登錄、未停權且在認證效期內的健檢中心(internal function)
+func requireActiveClinic(ctx contractapi.TransactionContextInterface) (*Clinic, error) {
+	_, role, err := getCaller(ctx)
+	if err != nil || role != "clinic" {
+		return nil, fmt.Errorf("only clinic can perform this operation")
+	}
+	clinicID := getClinicID(ctx)
+	if clinicID == "" {
+		return nil, fmt.Errorf("missing clinicId attribute in cert")
+	}
+	_, clinic, err := getClinic(ctx, clinicID)
+	if err != nil {
+		return nil, err
+	}
+	if clinic.Status != "ACTIVE" {
+		return nil, fmt.Errorf("clinic %s is suspended", clinicID)
+	}
+	now := txTime(ctx)
+	if now < clinic.AccreditedFrom || now > clinic.AccreditedUntil {
+		return nil, fmt.Errorf("clinic %s accreditation is not valid", clinicID)
+	}
+	return clinic, nil
+}
+
+// 讀取保險業者登錄資料(internal function)
+func getInsurer(ctx contractapi.TransactionContextInterface, insurerHash string) (string, *Insurer, error) {
+	key, _ := ctx.GetStub().CreateCompositeKey(keyInsurerNS, []string{insurerHash})
+	data, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read insurer: %v", err)
+	}
+	if data == nil {
+		return key, nil, fmt.Errorf("insurer is not registered")
+	}
+	var insurer Insurer
+	if err := json.Unmarshal(data, &insurer); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal insurer: %v", err)
+	}
+	return key, &insurer, nil
+}
+
+// 確認呼叫者為已登錄且未停權的保險業者(internal function)
+func requireActiveInsurer(ctx contractapi.TransactionContextInterface) (*Insurer, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil || role != "insurer" {
+		return nil, fmt.Errorf("only insurer can request access")
+	}
+	_, insurer, err := getInsurer(ctx, callerHash(ctx, userID))
+	if err != nil {
+		return nil, err
+	}
+	if insurer.Status != "ACTIVE" {
+		return nil, fmt.Errorf("insurer license is suspended")
+	}
+	return insurer, nil
+}
+
+// 查詢系統設定
+func (h *HealthCheckContract) GetSystemConfig(ctx contractapi.TransactionContextInterface) (*SystemConfig, error) {
+	_, cfg, err := getSystemConfig(ctx)
+	return cfg, err
+}
+
+// 設定病患回應授權請求的期限（秒），只允許 system 身份
+func (h *HealthCheckContract) SetRequestResponseWindow(ctx contractapi.TransactionContextInterface, seconds int64) error {
+	if err := requireSystem(ctx); err != nil {
+		return err
+	}
+	if seconds <= 0 {
+		return fmt.Errorf("response window must be positive")
+	}
+	key, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg.RequestResponseWindow = seconds
+	cfg.UpdatedAt = txTime(ctx)
+	cfg.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(cfg)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store system config")
+	}
+	return nil
+}
+
+// 設定待處理請求上限與拒絕後冷卻期間（秒），只允許 system 身份
+func (h *HealthCheckContract) SetRequestLimits(ctx contractapi.TransactionContextInterface, maxPending int32, cooldownSeconds int64) error {
+	if err := requireSystem(ctx); err != nil {
+		return err
+	}
+	if maxPending <= 0 || cooldownSeconds <= 0 {
+		return fmt.Errorf("request limits must be positive")
+	}
+	key, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg.MaxPendingRequests = maxPending
+	cfg.RejectionCooldown = cooldownSeconds
+	cfg.UpdatedAt = txTime(ctx)
+	cfg.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(cfg)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store system config")
+	}
+	return nil
+}
+
+// 開啟或關閉假名遷移模式，只允許 system 身份；遷移工具在所有帳號遷移完成後關閉
+func (h *HealthCheckContract) SetPseudonymMigrationMode(ctx contractapi.TransactionContextInterface, enabled bool) error {
+	if err := requireSystem(ctx); err != nil {
+		return err
+	}
+	key, cfg, err := getSystemConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg.PseudonymMigrationMode = enabled
+	cfg.UpdatedAt = txTime(ctx)
+	cfg.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(cfg)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store system config")
+	}
+	return nil
+}
+
+/**
+ * @notice 登錄或更新健檢中心資料（名稱、執照號碼、認證效期）
+ * @dev 只允許 admin 身份；重新登錄會恢復為 ACTIVE
+ * @param ctx Fabric合約上下文
+ * @param clinicID 健檢中心ID（對應憑證的 clinicId 屬性）
+ * @param name 健檢中心名稱
+ * @param licenseNumber 執照號碼
+ * @param accreditedFrom 認證起始時間（Unix 秒）
+ * @param accreditedUntil 認證到期時間（Unix 秒）
+ * @return error 權限或參數錯誤
+ */
+func (h *HealthCheckContract) RegisterClinic(ctx contractapi.TransactionContextInterface, clinicID, name, licenseNumber string, accreditedFrom, accreditedUntil int64) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if clinicID == "" || name == "" || licenseNumber == "" {
+		return fmt.Errorf("clinicId, name and licenseNumber are required")
+	}
+	if accreditedUntil <= accreditedFrom {
+		return fmt.Errorf("invalid accreditation period")
+	}
+
+	key, _ := ctx.GetStub().CreateCompositeKey(keyClinicNS, []string{clinicID})
+	clinic := Clinic{
+		DocType:         docClinic,
+		ClinicID:        clinicID,
+		Name:            name,
+		LicenseNumber:   licenseNumber,
+		AccreditedFrom:  accreditedFrom,
+		AccreditedUntil: accreditedUntil,
+		Status:          "ACTIVE",
+		UpdatedAt:       txTime(ctx),
+		WriterMSP:       getMSPID(ctx),
+	}
+	data, _ := json.Marshal(clinic)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store clinic")
+	}
+	return nil
+}
+
+// 停權健檢中心，停權後無法上傳或更正報告，只允許 admin 身份
+func (h *HealthCheckContract) SuspendClinic(ctx contractapi.TransactionContextInterface, clinicID, reason string) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if reason == "" {
+		return fmt.Errorf("suspend reason is required")
+	}
+	key, clinic, err := getClinic(ctx, clinicID)
+	if err != nil {
+		return err
+	}
+	clinic.Status = "SUSPENDED"
+	clinic.SuspendReason = reason
+	clinic.UpdatedAt = txTime(ctx)
+	clinic.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(clinic)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update clinic")
+	}
+	return nil
+}
+
+// 查詢健檢中心登錄資料
+func (h *HealthCheckContract) GetClinic(ctx contractapi.TransactionContextInterface, clinicID string) (*Clinic, error) {
+	if _, _, err := getCaller(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+	_, clinic, err := getClinic(ctx, clinicID)
+	return clinic, err
+}
+
+/**
+ * @notice 登錄或更新保險業者
+ * @dev 只允許 admin 身份；重新登錄會將狀態恢復為 ACTIVE
+ * @param ctx Fabric合約上下文
+ * @param insurerHash 保險業者假名（與憑證 pseudonym 屬性一致）
+ * @param legalName 公司法定名稱
+ * @param licenseNumber 保險業執照號碼
+ * @return error 權限或參數錯誤
+ */
+func (h *HealthCheckContract) RegisterInsurer(ctx contractapi.TransactionContextInterface, insurerHash, legalName, licenseNumber string) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if !isHexHash(insurerHash) || legalName == "" || licenseNumber == "" {
+		return fmt.Errorf("insurerHash, legalName and licenseNumber are required")
+	}
+
+	key, _ := ctx.GetStub().CreateCompositeKey(keyInsurerNS, []string{insurerHash})
+	insurer := Insurer{
+		DocType:       docInsurer,
+		InsurerHash:   insurerHash,
+		LegalName:     legalName,
+		LicenseNumber: licenseNumber,
+		Status:        "ACTIVE",
+		UpdatedAt:     txTime(ctx),
+		WriterMSP:     getMSPID(ctx),
+	}
+	data, _ := json.Marshal(insurer)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to store insurer")
+	}
+	return nil
+}
+
+// 停權保險業者，停權後無法發出授權請求，只允許 admin 身份
+func (h *HealthCheckContract) SuspendInsurer(ctx contractapi.TransactionContextInterface, insurerHash, reason string) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if reason == "" {
+		return fmt.Errorf("suspend reason is required")
+	}
+	key, insurer, err := getInsurer(ctx, insurerHash)
+	if err != nil {
+		return err
+	}
+	insurer.Status = "SUSPENDED"
+	insurer.SuspendReason = reason
+	insurer.UpdatedAt = txTime(ctx)
+	insurer.WriterMSP = getMSPID(ctx)
+	data, _ := json.Marshal(insurer)
+	if err := ctx.GetStub().PutState(key, data); err != nil {
+		return fmt.Errorf("failed to update insurer")
+	}
+	return nil
+}
+
+// 查詢保險業者登錄資料
+func (h *HealthCheckContract) GetInsurer(ctx contractapi.TransactionContextInterface, insurerHash string) (*Insurer, error) {
+	if _, _, err := getCaller(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+	_, insurer, err := getInsurer(ctx, insurerHash)
+	return insurer, err
+}
@@ -0,0 +1,800 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+type HealthReport struct {
+	DocType     string `json:"docType"`
+	ReportID    string `json:"reportId"`
+	PatientHash string `json:"patientHash"`
+	ClinicID    string `json:"clinicId"`
+	ResultJSON  string `json:"resultJson,omitempty"` // 僅舊資料使用，新報告內容存於私有資料集合
+	ResultHash  string `json:"resultHash,omitempty"`
+	CreatedAt   int64  `json:"createdAt"`
+	// 版本資訊：每次更正都寫入新版本，舊版本保留於 key history
+	Version       int    `json:"version,omitempty"`
+	TxID          string `json:"txId,omitempty"`
+	PreviousTxID  string `json:"previousTxId,omitempty"`
+	AmendReason   string `json:"amendReason,omitempty"`
+	AmendedAt     int64  `json:"amendedAt,omitempty"`
+	SchemaVersion int    `json:"schemaVersion,omitempty"` // 舊資料沒有此欄位，視為自由格式字串
+	WriterMSP     string `json:"writerMsp,omitempty"`
+}
+
+// 結構化檢驗結果（schemaVersion 2）：報告內容為以檢驗項目代碼為鍵的 JSON 物件，
+// 例如 {"Glu-AC": {"value": 95, "unit": "mg/dL", "referenceRange": {"low": 70, "high": 100}, "flag": "N"}}
+type LabResult struct {
+	Value          *float64        `json:"value,omitempty"`
+	Text           string          `json:"text,omitempty"` // 定性結果（如 "陰性"、"1+"），僅限 Flag 為 A，此時不提供 Value
+	Unit           string          `json:"unit,omitempty"`
+	ReferenceRange *ReferenceRange `json:"referenceRange"`
+	Flag           string          `json:"flag"` // N 正常、L/H 偏低/偏高、LL/HH 危急值、A 非數值判讀（定性結果）
+	Specimen       string          `json:"specimen,omitempty"`
+	Method         string          `json:"method,omitempty"`
+	// 由 go_server 依檢驗項目字典正規化時填入，此時鍵為 LOINC 代碼，原始代碼保留於 LocalCode
+	Loinc     string `json:"loinc,omitempty"`
+	LocalCode string `json:"localCode,omitempty"`
+	LocalUnit string `json:"localUnit,omitempty"` // 換算為標準單位前的原始單位
+	NameZH    string `json:"nameZh,omitempty"`
+	NameEN    string `json:"nameEn,omitempty"`
+}
+
+// 參考範圍，可只有單邊界限；無法以數值表示時使用 Text（如 "陰性"）
+type ReferenceRange struct {
+	Low  *float64 `json:"low,omitempty"`
+	High *float64 `json:"high,omitempty"`
+	Text string   `json:"text,omitempty"`
+}
+
+// 只包含 metadata
+type ReportMeta struct {
+	ReportID      string `json:"reportId"`
+	ClinicID      string `json:"clinicId"`
+	ClinicName    string `json:"clinicName,omitempty"` // 取自鏈上健檢中心登錄資料
+	CreatedAt     int64  `json:"createdAt"`
+	Version       int    `json:"version"`
+	SchemaVersion int    `json:"schemaVersion"`
+}
+
+// 讀取報告時回傳的內容，包含目前讀取的版本
+type ReportContent struct {
+	ReportID      string `json:"reportId"`
+	ClinicID      string `json:"clinicId"`
+	Version       int    `json:"version"`
+	ResultJSON    string `json:"resultJson"`
+	CreatedAt     int64  `json:"createdAt"`
+	AmendedAt     int64  `json:"amendedAt,omitempty"`
+	SchemaVersion int    `json:"schemaVersion"`
+}
+
+// 報告的單一歷史版本（來自 GetHistoryForKey）
+type ReportVersion struct {
+	TxID        string `json:"txId"`
+	Timestamp   int64  `json:"timestamp"`
+	Version     int    `json:"version"`
+	ResultJSON  string `json:"resultJson,omitempty"` // 舊資料取自 world state，新資料取自私有資料集合的版本內容
+	ResultHash  string `json:"resultHash,omitempty"`
+	AmendReason string `json:"amendReason,omitempty"`
+	IsDelete    bool   `json:"isDelete,omitempty"`
+}
+
+// 分頁查詢結果，bookmark 為空代表沒有下一頁
+type ReportMetaPage struct {
+	Records      []ReportMeta `json:"records"`
+	Bookmark     string       `json:"bookmark"`
+	FetchedCount int32        `json:"fetchedCount"`
+}
+
+// 健檢中心對帳用的報告摘要，不含報告內容
+type ClinicReport struct {
+	ReportID            string `json:"reportId"`
+	PatientHash         string `json:"patientHash"`
+	CreatedAt           int64  `json:"createdAt"`
+	Version             int    `json:"version"`
+	ResultHash          string `json:"resultHash,omitempty"` // 可與 LIS 的報告內容雜湊比對
+	AmendedAt           int64  `json:"amendedAt,omitempty"`
+	AmendReason         string `json:"amendReason,omitempty"`
+	AccessRequestCount  int    `json:"accessRequestCount"`
+	PendingRequestCount int    `json:"pendingRequestCount"`
+}
+
+type ClinicReportPage struct {
+	Records      []ClinicReport `json:"records"`
+	Bookmark     string         `json:"bookmark"`
+	FetchedCount int32          `json:"fetchedCount"`
+}
+
+// 健檢中心在查詢期間內的上傳統計
+type ClinicUploadStats struct {
+	ClinicID     string `json:"clinicId"`
+	FromDate     int64  `json:"fromDate,omitempty"`
+	ToDate       int64  `json:"toDate,omitempty"`
+	UploadCount  int    `json:"uploadCount"`
+	AmendedCount int    `json:"amendedCount"`
+}
+
+// 舊資料沒有版本欄位，視為第 1 版
+func reportVersion(rep HealthReport) int {
+	if rep.Version == 0 {
+		return 1
+	}
+	return rep.Version
+}
+
+// 舊資料沒有 schemaVersion 欄位，視為自由格式字串
+func reportSchemaVersion(rep HealthReport) int {
+	if rep.SchemaVersion == 0 {
+		return schemaVersionLegacy
+	}
+	return rep.SchemaVersion
+}
+
+func toReportContent(rep HealthReport, resultJSON string) ReportContent {
+	return ReportContent{
+		ReportID:      rep.ReportID,
+		ClinicID:      rep.ClinicID,
+		Version:       reportVersion(rep),
+		ResultJSON:    resultJSON,
+		CreatedAt:     rep.CreatedAt,
+		AmendedAt:     rep.AmendedAt,
+		SchemaVersion: reportSchemaVersion(rep),
+	}
+}
+
+// 取得報告(internal function)
+func getReport(ctx contractapi.TransactionContextInterface, reportID string) (string, *HealthReport, error) {
+	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
+	data, err := ctx.GetStub().GetState(repKey)
+	if err != nil || data == nil {
+		return repKey, nil, fmt.Errorf("report not found")
+	}
+	var rep HealthReport
+	if err := json.Unmarshal(data, &rep); err != nil {
+		return repKey, nil, fmt.Errorf("failed to parse report")
+	}
+	return repKey, &rep, nil
+}
+
+func hashResult(resultJSON []byte) string {
+	hash := sha256.Sum256(resultJSON)
+	return hex.EncodeToString(hash[:])
+}
+
+// 從 transient data 取得報告內容，避免原始數值寫入區塊(internal function)
+func getTransientResult(ctx contractapi.TransactionContextInterface) ([]byte, error) {
+	transient, err := ctx.GetStub().GetTransient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transient data: %v", err)
+	}
+	result, ok := transient[transientResultKey]
+	if !ok || len(result) == 0 {
+		return nil, fmt.Errorf("%s must be provided in transient data", transientResultKey)
+	}
+	return result, nil
+}
+
+// JSON 型別名稱，用於錯誤訊息(internal function)
+func jsonKind(k reflect.Kind) string {
+	switch k {
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	default:
+		return "an object"
+	}
+}
+
+// 檢查結構化檢驗結果(internal function)，一次回傳所有欄位的錯誤，格式為 "<代碼>.<欄位>: <原因>"
+func validateLabResults(resultJSON []byte) error {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(resultJSON, &all); err != nil || all == nil {
+		return fmt.Errorf("invalid lab results: report content must be a JSON object keyed by analyte code")
+	}
+	if len(all) == 0 {
+		return fmt.Errorf("invalid lab results: at least one analyte is required")
+	}
+	codes := make([]string, 0, len(all))
+	for code := range all {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var errs []string
+	for _, code := range codes {
+		if strings.TrimSpace(code) == "" {
+			errs = append(errs, "analyte code must not be empty")
+			continue
+		}
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(all[code], &keys); err != nil || keys == nil {
+			errs = append(errs, code+": must be an object with value (or text), unit, referenceRange and flag")
+			continue
+		}
+		unknown := false
+		for k := range keys {
+			switch k {
+			case "value", "text", "unit", "referenceRange", "flag", "specimen", "method",
+				"loinc", "localCode", "localUnit", "nameZh", "nameEn":
+			default:
+				errs = append(errs, fmt.Sprintf("%s.%s: unknown field", code, k))
+				unknown = true
+			}
+		}
+		if unknown {
+			continue
+		}
+		var r LabResult
+		if err := json.Unmarshal(all[code], &r); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) && typeErr.Field != "" {
+				errs = append(errs, fmt.Sprintf("%s.%s: must be %s", code, typeErr.Field, jsonKind(typeErr.Type.Kind())))
+			} else {
+				errs = append(errs, fmt.Sprintf("%s: %v", code, err))
+			}
+			continue
+		}
+		// 定性結果以 text 取代 value，單位可省略
+		switch {
+		case strings.TrimSpace(r.Text) != "":
+			if r.Flag != "A" {
+				errs = append(errs, code+".text: only allowed when flag is A")
+			}
+			if r.Value != nil {
+				errs = append(errs, code+".value: must not be set together with text")
+			}
+		default:
+			if r.Value == nil {
+				errs = append(errs, code+".value: is required")
+			}
+			if strings.TrimSpace(r.Unit) == "" {
+				errs = append(errs, code+".unit: is required")
+			}
+		}
+		switch {
+		case r.ReferenceRange == nil:
+			errs = append(errs, code+".referenceRange: is required")
+		case r.ReferenceRange.Low == nil && r.ReferenceRange.High == nil && strings.TrimSpace(r.ReferenceRange.Text) == "":
+			errs = append(errs, code+".referenceRange: low, high or text is required")
+		case r.ReferenceRange.Low != nil && r.ReferenceRange.High != nil && *r.ReferenceRange.Low > *r.ReferenceRange.High:
+			errs = append(errs, code+".referenceRange: low must not exceed high")
+		}
+		switch r.Flag {
+		case "N", "L", "H", "LL", "HH", "A":
+		case "":
+			errs = append(errs, code+".flag: is required")
+		default:
+			errs = append(errs, fmt.Sprintf("%s.flag: unsupported flag %q (N, L, H, LL, HH or A)", code, r.Flag))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid lab results: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// 讀取報告內容(internal function)：新資料從私有資料集合讀取並比對雜湊，舊資料直接使用 world state
+func readReportResult(ctx contractapi.TransactionContextInterface, repKey string, rep HealthReport) (string, error) {
+	if rep.ResultHash == "" {
+		return rep.ResultJSON, nil
+	}
+	return readPrivateResult(ctx, repKey, rep.ResultHash)
+}
+
+// 讀取私有資料集合中的報告內容並比對雜湊(internal function)
+func readPrivateResult(ctx contractapi.TransactionContextInterface, key, resultHash string) (string, error) {
+	data, err := ctx.GetStub().GetPrivateData(collectionReportResults, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to read report content: %v", err)
+	}
+	if data == nil {
+		return "", fmt.Errorf("report content not available (purged or not disseminated to this peer)")
+	}
+	if hashResult(data) != resultHash {
+		return "", fmt.Errorf("report content does not match on-chain hash")
+	}
+	return string(data), nil
+}
+
+// 報告某一版本內容在私有資料集合中的 key(internal function)
+func reportVersionKey(ctx contractapi.TransactionContextInterface, reportID string, version int) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(keyReportVersionNS, []string{reportID, strconv.Itoa(version)})
+	if err != nil {
+		return "", fmt.Errorf("failed to create report version key: %v", err)
+	}
+	return key, nil
+}
+
+// 建立欄位名稱與報告內容鍵的對應(internal function)。報告正規化後改以 LOINC 為鍵，
+// 以健檢中心原始代碼建立的授權、條件與同意政策透過 localCode 找到對應項目；反之亦可用 loinc 找到舊報告的項目
+func analyteKeys(all map[string]json.RawMessage) map[string]string {
+	keys := make(map[string]string, len(all))
+	for k := range all {
+		keys[k] = k
+	}
+	// 依鍵排序處理，多個項目標註相同代碼時結果固定
+	sorted := make([]string, 0, len(all))
+	for k := range all {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		var lab LabResult
+		if json.Unmarshal(all[k], &lab) != nil {
+			continue
+		}
+		for _, alias := range []string{lab.LocalCode, lab.Loinc} {
+			if _, taken := keys[alias]; alias != "" && !taken {
+				keys[alias] = k
+			}
+		}
+	}
+	return keys
+}
+
+// 依授權欄位遮蔽報告內容(internal function)，未指定欄位時回傳完整內容
+func redactResult(resultJSON string, fields []string) (string, error) {
+	if len(fields) == 0 {
+		return resultJSON, nil
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(resultJSON), &all); err != nil {
+		return "", fmt.Errorf("report content is not a JSON object, cannot apply field restriction")
+	}
+	keys := analyteKeys(all)
+	granted := make(map[string]json.RawMessage)
+	for _, f := range fields {
+		if k, ok := keys[f]; ok {
+			granted[k] = all[k]
+		}
+	}
+	out, err := json.Marshal(granted)
+	if err != nil {
+		return "", fmt.Errorf("failed to redact report content: %v", err)
+	}
+	return string(out), nil
+}
+
+// 分頁查詢報告 meta(internal function)
+func queryReportMetaPage(ctx contractapi.TransactionContextInterface, patientHash string, pageSize int32, bookmark string, filter ListFilter) (*ReportMetaPage, error) {
+	selector := map[string]interface{}{
+		"docType":     docHealth,
+		"patientHash": patientHash,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["createdAt"] = r
+	}
+	if filter.ClinicID != "" {
+		selector["clinicId"] = filter.ClinicID
+	}
+
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	page := &ReportMetaPage{Records: []ReportMeta{}, Bookmark: next, FetchedCount: fetched}
+	clinicNames := make(map[string]string)
+	for _, v := range values {
+		var report HealthReport
+		if err := json.Unmarshal(v, &report); err != nil {
+			continue
+		}
+		name, ok := clinicNames[report.ClinicID]
+		if !ok {
+			if _, clinic, err := getClinic(ctx, report.ClinicID); err == nil {
+				name = clinic.Name
+			}
+			clinicNames[report.ClinicID] = name
+		}
+		page.Records = append(page.Records, ReportMeta{
+			ReportID:      report.ReportID,
+			ClinicID:      report.ClinicID,
+			ClinicName:    name,
+			CreatedAt:     report.CreatedAt,
+			Version:       reportVersion(report),
+			SchemaVersion: reportSchemaVersion(report),
+		})
+	}
+	return page, nil
+}
+
+// 上傳報告，報告內容需透過 transient data 的 resultJson 傳入
+func (h *HealthCheckContract) UploadReport(ctx contractapi.TransactionContextInterface, reportID, patientHash string) error {
+	clinic, err := requireActiveClinic(ctx)
+	if err != nil {
+		return err
+	}
+
+	repKey, _ := ctx.GetStub().CreateCompositeKey(keyReportNS, []string{reportID})
+	b, _ := ctx.GetStub().GetState(repKey)
+	if b != nil {
+		return fmt.Errorf("reportID already exists")
+	}
+
+	resultJSON, err := getTransientResult(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validateLabResults(resultJSON); err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutPrivateData(collectionReportResults, repKey, resultJSON); err != nil {
+		return fmt.Errorf("failed to store report content: %v", err)
+	}
+
+	rec := HealthReport{
+		DocType:       docHealth,
+		ReportID:      reportID,
+		PatientHash:   patientHash,
+		ClinicID:      clinic.ClinicID,
+		ResultHash:    hashResult(resultJSON),
+		CreatedAt:     txTime(ctx),
+		Version:       1,
+		SchemaVersion: schemaVersionLabResult,
+		TxID:          ctx.GetStub().GetTxID(),
+		WriterMSP:     getMSPID(ctx),
+	}
+	bytes, _ := json.Marshal(rec)
+	if err := ctx.GetStub().PutState(repKey, bytes); err != nil {
+		return fmt.Errorf("failed to store report: %v", err)
+	}
+
+	return pushPolicyTickets(ctx, rec)
+}
+
+/**
+ * @notice 健檢中心更正已上傳的報告，寫入新版本並連結前一版
+ * @dev 只允許原上傳的 clinic，舊版本可透過 GetReportHistory 查詢
+ * @param ctx Fabric合約上下文
+ * @param reportID 報告ID
+ * @param reason 更正原因（更正後的內容透過 transient data 的 resultJson 傳入）
+ * @return int 新版本號, error 更正失敗或無權限
+ */
+func (h *HealthCheckContract) AmendReport(ctx contractapi.TransactionContextInterface, reportID, reason string) (int, error) {
+	clinic, err := requireActiveClinic(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if reason == "" {
+		return 0, fmt.Errorf("amendment reason is required")
+	}
+
+	repKey, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return 0, err
+	}
+	if rep.ClinicID != clinic.ClinicID {
+		return 0, fmt.Errorf("only the originating clinic can amend this report")
+	}
+
+	resultJSON, err := getTransientResult(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 舊格式報告更正時一併改為結構化檢驗結果
+	if err := validateLabResults(resultJSON); err != nil {
+		return 0, err
+	}
+	// 覆寫前先將目前內容寫入版本 key，GetReportHistory 才能讀回舊版本；舊資料內容仍在 world state 的 key history 中
+	if rep.ResultHash != "" {
+		prior, err := readReportResult(ctx, repKey, *rep)
+		if err != nil {
+			return 0, fmt.Errorf("failed to preserve previous version: %v", err)
+		}
+		versionKey, err := reportVersionKey(ctx, reportID, reportVersion(*rep))
+		if err != nil {
+			return 0, err
+		}
+		if err := ctx.GetStub().PutPrivateData(collectionReportResults, versionKey, []byte(prior)); err != nil {
+			return 0, fmt.Errorf("failed to store previous version: %v", err)
+		}
+	}
+	if err := ctx.GetStub().PutPrivateData(collectionReportResults, repKey, resultJSON); err != nil {
+		return 0, fmt.Errorf("failed to store report content: %v", err)
+	}
+
+	rep.PreviousTxID = rep.TxID
+	rep.TxID = ctx.GetStub().GetTxID()
+	rep.Version = reportVersion(*rep) + 1
+	rep.ResultJSON = ""
+	rep.ResultHash = hashResult(resultJSON)
+	rep.SchemaVersion = schemaVersionLabResult
+	rep.AmendReason = reason
+	rep.AmendedAt = txTime(ctx)
+	rep.WriterMSP = getMSPID(ctx)
+	bytes, _ := json.Marshal(rep)
+	if err := ctx.GetStub().PutState(repKey, bytes); err != nil {
+		return 0, fmt.Errorf("failed to store amended report")
+	}
+
+	eventPayload, _ := json.Marshal(map[string]interface{}{
+		"reportId":     rep.ReportID,
+		"patientHash":  rep.PatientHash,
+		"clinicId":     rep.ClinicID,
+		"version":      rep.Version,
+		"previousTxId": rep.PreviousTxID,
+		"amendedAt":    rep.AmendedAt,
+	})
+	if err := ctx.GetStub().SetEvent("ReportAmended", eventPayload); err != nil {
+		return 0, fmt.Errorf("failed to set event")
+	}
+
+	return rep.Version, nil
+}
+
+/**
+ * @notice 查詢報告的所有歷史版本（依 key history），依時間由舊到新排序
+ * @dev 允許報告所屬病患、原上傳 clinic，或持有有效授權票據的 insurer；
+ *      各版本內容取自私有資料集合並比對該版本雜湊，本節點沒有內容時該版本只回傳雜湊
+ * @param ctx Fabric合約上下文
+ * @param reportID 報告ID
+ * @return []ReportVersion 版本陣列, error 查詢失敗或無權限
+ */
+func (h *HealthCheckContract) GetReportHistory(ctx contractapi.TransactionContextInterface, reportID string) ([]ReportVersion, error) {
+	userID, role, err := getCaller(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caller identity: %v", err)
+	}
+
+	repKey, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	var ticket *AuthTicket
+	switch role {
+	case "patient":
+		if rep.PatientHash != callerHash(ctx, userID) {
+			return nil, fmt.Errorf("not authorized to read this report")
+		}
+	case "clinic":
+		if clinicID := getClinicID(ctx); clinicID == "" || rep.ClinicID != clinicID {
+			return nil, fmt.Errorf("not authorized to read this report")
+		}
+	case "insurer":
+		if ticket, err = findValidTicket(ctx, rep.PatientHash, callerHash(ctx, userID), reportID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("not authorized to read this report")
+	}
+
+	iter, err := ctx.GetStub().GetHistoryForKey(repKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report history: %v", err)
+	}
+	defer iter.Close()
+
+	var results []ReportVersion
+	for iter.HasNext() {
+		km, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		v := ReportVersion{
+			TxID:      km.TxId,
+			Timestamp: km.Timestamp.GetSeconds(),
+			IsDelete:  km.IsDelete,
+		}
+		var old HealthReport
+		if !km.IsDelete && json.Unmarshal(km.Value, &old) == nil {
+			v.Version = reportVersion(old)
+			v.ResultJSON = versionResult(ctx, repKey, *rep, old)
+			if ticket != nil && v.ResultJSON != "" {
+				// 保險業者只能看到票據授權的欄位，條件授權則不回傳內容
+				if ticket.AccessMode == accessModePredicate {
+					v.ResultJSON = ""
+				} else if v.ResultJSON, err = redactResult(v.ResultJSON, ticket.GrantedFields); err != nil {
+					v.ResultJSON = ""
+				}
+			}
+			v.ResultHash = old.ResultHash
+			v.AmendReason = old.AmendReason
+		}
+		results = append(results, v)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Timestamp < results[j].Timestamp
+	})
+	return results, nil
+}
+
+// 取得報告某一歷史版本的內容(internal function)：目前版本讀報告 key，更正前的版本讀版本 key，
+// 內容不存在或雜湊不符時回傳空字串
+func versionResult(ctx contractapi.TransactionContextInterface, repKey string, current, old HealthReport) string {
+	if old.ResultHash == "" {
+		return old.ResultJSON
+	}
+	key := repKey
+	if reportVersion(old) != reportVersion(current) {
+		var err error
+		if key, err = reportVersionKey(ctx, current.ReportID, reportVersion(old)); err != nil {
+			return ""
+		}
+	}
+	content, err := readPrivateResult(ctx, key, old.ResultHash)
+	if err != nil {
+		return ""
+	}
+	return content
+}
+
+/**
+ * @notice 查詢自己的所有健檢報告，只回傳 metadata，不含內容
+ * @dev 只允許 patient 身份，回傳該 patient 的報告 meta 資訊（分頁）；filter.PatientHash 供 READ 代理人查詢被代理病患
+ * @param ctx Fabric合約上下文
+ * @param pageSize 每頁筆數，0 使用預設值
+ * @param bookmark 上一頁回傳的 bookmark，第一頁傳空字串
+ * @param filter 建立時間區間與健檢中心篩選
+ * @return ReportMetaPage 報告meta分頁, error 查詢失敗或權限錯誤
+ */
+func (h *HealthCheckContract) ListMyReportMeta(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*ReportMetaPage, error) {
+	patientHash, _, err := actingPatient(ctx, filter.PatientHash, delegateScopeRead)
+	if err != nil {
+		return nil, err
+	}
+	return queryReportMetaPage(ctx, patientHash, pageSize, bookmark, filter)
+}
+
+/**
+ * @notice 病患讀取自己的報告詳細內容（需先取得 meta 再查詢內容）
+ * @dev 只允許 patient 身份，只能讀自己擁有的報告或持有 READ 代理授權的被代理病患報告
+ * @param ctx Fabric合約上下文
+ * @param reportID 報告ID
+ * @return ReportContent 報告內容與版本, error 查詢失敗或無權限
+ */
+func (h *HealthCheckContract) ReadMyReport(ctx contractapi.TransactionContextInterface, reportID string) (*ReportContent, error) {
+	if _, role, err := getCaller(ctx); err != nil || role != "patient" {
+		return nil, fmt.Errorf("only patient can read own report")
+	}
+	// 查詢報告內容
+	repKey, rep, err := getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	// 病患本人或持有 READ 代理授權者
+	if _, _, err := actingPatient(ctx, rep.PatientHash, delegateScopeRead); err != nil {
+		return nil, fmt.Errorf("not authorized to read this report")
+	}
+	resultJSON, err := readReportResult(ctx, repKey, *rep)
+	if err != nil {
+		return nil, err
+	}
+	content := toReportContent(*rep, resultJSON)
+	return &content, nil
+}
+
+/**
+ * @notice 查詢特定病患的所有健檢報告 meta，只回傳 meta，不含內容
+ * @dev 只允許 insurer 與 emergency 身份，需傳入病患假名（由 go_server 計算，避免身分證號寫入交易）
+ * @param ctx Fabric合約上下文
+ * @param patientHash 病患假名
+ * @param pageSize 每頁筆數，0 使用預設值
+ * @param bookmark 上一頁回傳的 bookmark，第一頁傳空字串
+ * @param filter 建立時間區間與健檢中心篩選
+ * @return ReportMetaPage 報告meta分頁, error 查詢失敗或權限錯誤
+ */
+func (h *HealthCheckContract) ListReportMetaByPatientID(ctx contractapi.TransactionContextInterface, patientHash string, pageSize int32, bookmark string, filter ListFilter) (*ReportMetaPage, error) {
+	_, role, err := getCaller(ctx)
+	if err != nil || (role != "insurer" && role != "emergency") {
+		return nil, fmt.Errorf("only insurer or emergency staff can query report meta")
+	}
+	return queryReportMetaPage(ctx, patientHash, pageSize, bookmark, filter)
+}
+
+// 取得呼叫者所屬的健檢中心ID(internal function)，停權中的健檢中心仍可查詢自己上傳的報告
+func callerClinicID(ctx contractapi.TransactionContextInterface) (string, error) {
+	_, role, err := getCaller(ctx)
+	if err != nil || role != "clinic" {
+		return "", fmt.Errorf("only clinic can query uploaded reports")
+	}
+	clinicID := getClinicID(ctx)
+	if clinicID == "" {
+		return "", fmt.Errorf("clinic id not found in certificate")
+	}
+	return clinicID, nil
+}
+
+/**
+ * @notice 健檢中心查詢自己上傳的報告，供與 LIS 對帳
+ * @dev 只允許 clinic 身份，依憑證的 clinicId 篩選；每筆附上授權請求數與更正狀態
+ * @param ctx Fabric合約上下文
+ * @param pageSize 每頁筆數，0 使用預設值
+ * @param bookmark 上一頁回傳的 bookmark，第一頁傳空字串
+ * @param filter 建立時間區間篩選
+ * @return ClinicReportPage 報告摘要分頁, error 查詢失敗或權限錯誤
+ */
+func (h *HealthCheckContract) ListReportsByClinic(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string, filter ListFilter) (*ClinicReportPage, error) {
+	clinicID, err := callerClinicID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"docType":  docHealth,
+		"clinicId": clinicID,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["createdAt"] = r
+	}
+	values, next, fetched, err := queryPage(ctx, selector, pageSize, bookmark)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ClinicReportPage{Records: []ClinicReport{}, Bookmark: next, FetchedCount: fetched}
+	for _, v := range values {
+		var rep HealthReport
+		if err := json.Unmarshal(v, &rep); err != nil {
+			continue
+		}
+		total, pending, err := countAccessRequests(ctx, rep.ReportID)
+		if err != nil {
+			return nil, err
+		}
+		page.Records = append(page.Records, ClinicReport{
+			ReportID:            rep.ReportID,
+			PatientHash:         rep.PatientHash,
+			CreatedAt:           rep.CreatedAt,
+			Version:             reportVersion(rep),
+			ResultHash:          rep.ResultHash,
+			AmendedAt:           rep.AmendedAt,
+			AmendReason:         rep.AmendReason,
+			AccessRequestCount:  total,
+			PendingRequestCount: pending,
+		})
+	}
+	return page, nil
+}
+
+// 健檢中心查詢期間內的上傳數與更正數，filter 只使用建立時間區間
+func (h *HealthCheckContract) GetClinicUploadStats(ctx contractapi.TransactionContextInterface, filter ListFilter) (*ClinicUploadStats, error) {
+	clinicID, err := callerClinicID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := map[string]interface{}{
+		"docType":  docHealth,
+		"clinicId": clinicID,
+	}
+	if r := dateRange(filter); r != nil {
+		selector["createdAt"] = r
+	}
+	query, _ := json.Marshal(map[string]interface{}{
+		"selector": selector,
+		"fields":   []string{"version"},
+	})
+	iter, err := ctx.GetStub().GetQueryResult(string(query))
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %v", err)
+	}
+	defer iter.Close()
+
+	stats := &ClinicUploadStats{ClinicID: clinicID, FromDate: filter.FromDate, ToDate: filter.ToDate}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			continue
+		}
+		var rep HealthReport
+		if err := json.Unmarshal(kv.Value, &rep); err != nil {
+			continue
+		}
+		stats.UploadCount++
+		if reportVersion(rep) > 1 {
+			stats.AmendedCount++
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	db "go_server/database"
+	fc "go_server/fabric"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-ca/api"
+)
+
+// 建立急診人員帳號（role=emergency），可在病患無法核准時使用緊急存取（break-glass）
+// 於 go_server 目錄執行：EMERGENCY_ID=... EMERGENCY_PASSWORD=... EMERGENCY_NAME=... EMERGENCY_FACILITY=... go run ./admin/emergency
+func main() {
+	err := db.InitDB("database/user_data.sqlite")
+	if err != nil {
+		log.Fatalf("❌ SQLite 初始化失敗: %v", err)
+	}
+	userId := os.Getenv("EMERGENCY_ID")
+	password := os.Getenv("EMERGENCY_PASSWORD")
+	name := os.Getenv("EMERGENCY_NAME")
+	facility := os.Getenv("EMERGENCY_FACILITY")
+	if userId == "" || password == "" || name == "" || facility == "" {
+		log.Fatalf("請設定 EMERGENCY_ID、EMERGENCY_PASSWORD、EMERGENCY_NAME 與 EMERGENCY_FACILITY")
+	}
+
+	// ✅ 檢查是否已存在
+	exists, err := db.IsEmergencyStaffExists(userId)
+	if err != nil {
+		log.Fatalf("查詢資料庫失敗: %v", err)
+	}
+	if exists {
+		log.Fatalf("此急診人員帳號已存在: %s", userId)
+	}
+
+	// ✅ Fabric CA 註冊
+	err = fc.RegisterUser(
+		"http://localhost:7054",
+		"../orgs/org1.example.com/users/org1-admin/msp/signcerts/cert.pem",
+		"../orgs/org1.example.com/users/org1-admin/msp/keystore/server.key",
+		api.RegistrationRequest{
+			Name:        userId,
+			Secret:      password,
+			Type:        "client",
+			Affiliation: "org1.department1",
+			Attributes: []api.Attribute{
+				{Name: "role", Value: "emergency", ECert: true},
+			},
+		},
+	)
+	if err != nil {
+		log.Fatalf("Fabric 註冊失敗: %v", err)
+	}
+	fmt.Println("✅ CA 註冊成功")
+
+	// ✅ 產生 CSR & 金鑰
+	privKey, csrPEM, err := fc.GenerateCSR(userId)
+	if err != nil {
+		log.Fatalf("產生 CSR 失敗: %v", err)
+	}
+
+	baseDir := filepath.Join("msp-data", "emergency", userId)
+	os.MkdirAll(filepath.Join(baseDir, "keystore"), 0700)
+	os.MkdirAll(filepath.Join(baseDir, "signcerts"), 0700)
+
+	keyPath := filepath.Join(baseDir, "keystore", "key.pem")
+	if err := fc.SavePrivateKeyToFile(privKey, keyPath); err != nil {
+		log.Fatalf("❌ 寫入私鑰失敗: %v", err)
+	}
+
+	// ✅ Enroll（用自己產生的 CSR）
+	certPem, err := fc.EnrollUser("http://localhost:7054", userId, password, fc.EnrollRequest{
+		Certificate_request: string(csrPEM),
+	})
+	if err != nil {
+		log.Fatalf("Enroll 失敗: %v", err)
+	}
+
+	certPath := filepath.Join(baseDir, "signcerts", "cert.pem")
+	if err := fc.SaveCertToFile(certPem, certPath); err != nil {
+		log.Fatalf("❌ 寫入證書失敗: %v", err)
+	}
+
+	// ✅ 寫入 wallet
+	w := wl.New()
+	if err := w.PutFile(userId, certPath, keyPath, "Org1MSP"); err != nil {
+		log.Fatalf("錢包寫入失敗: %v", err)
+	}
+
+	// ✅ 寫入 SQLite
+	if err := db.InsertEmergencyStaff(userId, password, name, facility); err != nil {
+		log.Fatalf("資料庫寫入失敗: %v", err)
+	}
+
+	fmt.Println("🎉 急診人員帳號建立完成！")
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	db "go_server/database"
+	fc "go_server/fabric"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-ca/api"
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+)
+
+const (
+	caURL        = "http://localhost:7054"
+	caAdminCert  = "../orgs/org1.example.com/users/org1-admin/msp/signcerts/cert.pem"
+	caAdminKey   = "../orgs/org1.example.com/users/org1-admin/msp/keystore/server.key"
+	migrateBatch = 50
+)
+
+// 將既有病患與保險業者從 SHA-256(身分證號) 遷移到 HMAC 假名：
+//  1. 以 CA admin 為身份加上 pseudonym 屬性並重設 enrollment secret，重新 enroll 取得新憑證
+//  2. 以 system 身份呼叫鏈碼 MigratePseudonym 改寫 HealthReport / AuthTicket / AccessRequest / Insurer，新舊假名只經由 transient data 傳遞
+//  3. 更新錢包憑證與 SQLite 的 pseudonym 欄位
+//  4. 所有帳號都遷移成功後關閉鏈上的假名遷移模式，之後沒有 pseudonym 屬性的憑證會被鏈碼拒絕
+//
+// 需先設定 PSEUDONYM_KEY 並建立 system 身份，建議停止 go_server 後於 go_server 目錄執行：go run ./admin/migrate
+// 部署新鏈碼後、完成遷移前若需讓舊憑證暫時可用，以 PSEUDONYM_MIGRATION_MODE=on 執行只會開啟遷移模式
+func main() {
+	if os.Getenv("PSEUDONYM_KEY") == "" {
+		log.Fatalf("請先設定 PSEUDONYM_KEY")
+	}
+	if err := db.InitDB("database/user_data.sqlite"); err != nil {
+		log.Fatalf("❌ SQLite 初始化失敗: %v", err)
+	}
+	w := wl.New()
+
+	peer, err := fc.NewPeer(
+		"localhost:7051",
+		"../orgs/org1.example.com/peers/peer1.org1.example.com/tls/ca.crt",
+		"peer1.org1.example.com",
+	)
+	if err != nil {
+		log.Fatalf("❌ Peer 連線失敗: %v", err)
+	}
+	builder := fc.GWBuilder{
+		Peer:    peer,
+		Channel: "channel1",
+		CCName:  "health",
+	}
+
+	systemID := os.Getenv("SYSTEM_IDENTITY")
+	if systemID == "" {
+		systemID = "system"
+	}
+	sysEntry, ok := w.Get(systemID)
+	if !ok {
+		log.Fatalf("錢包中沒有 system 身份: %s", systemID)
+	}
+
+	if os.Getenv("PSEUDONYM_MIGRATION_MODE") == "on" {
+		if err := setMigrationMode(builder, sysEntry, true); err != nil {
+			log.Fatalf("❌ 開啟假名遷移模式失敗: %v", err)
+		}
+		fmt.Println("✅ 已開啟假名遷移模式，完成遷移後會自動關閉")
+		return
+	}
+
+	labels, err := w.List()
+	if err != nil {
+		log.Fatalf("無法列出錢包條目: %v", err)
+	}
+
+	migrated, failed := 0, 0
+	for _, label := range labels {
+		var (
+			dir          string
+			oldHash      string
+			setPseudonym func(id, pseudonym string) error
+		)
+		if exists, _ := db.IsUserExists(label); exists {
+			dir, oldHash, setPseudonym = "users", db.ResolveUserHash(label), db.SetUserPseudonym
+		} else if exists, _ := db.IsInsurerExists(label); exists {
+			dir, oldHash, setPseudonym = "insurers", db.ResolveInsurerHash(label), db.SetInsurerPseudonym
+		} else {
+			continue // 健檢中心與 system 身份不使用假名
+		}
+
+		newHash := db.Pseudonym(label)
+		if oldHash == newHash {
+			continue
+		}
+		if err := migrateIdentity(w, builder, sysEntry, label, dir, oldHash, newHash); err != nil {
+			log.Printf("❌ %s 遷移失敗: %v", label, err)
+			failed++
+			continue
+		}
+		if err := setPseudonym(label, newHash); err != nil {
+			log.Printf("❌ %s 更新資料庫假名失敗（重新執行即可補上）: %v", label, err)
+			failed++
+			continue
+		}
+		migrated++
+		log.Printf("✅ %s 已遷移", label)
+	}
+
+	if failed > 0 {
+		log.Fatalf("❌ %d 個帳號遷移失敗，假名遷移模式維持不變，請重新執行", failed)
+	}
+	if err := setMigrationMode(builder, sysEntry, false); err != nil {
+		log.Fatalf("❌ 關閉假名遷移模式失敗: %v", err)
+	}
+	fmt.Printf("🎉 假名遷移完成，共 %d 個帳號\n", migrated)
+}
+
+// setMigrationMode 開啟或關閉鏈上的假名遷移模式
+func setMigrationMode(builder fc.GWBuilder, sysEntry *wl.Entry, enabled bool) error {
+	contract, gw, err := builder.NewContract(sysEntry.ID, sysEntry.Signer)
+	if err != nil {
+		return err
+	}
+	defer gw.Close()
+	if _, err := contract.SubmitTransaction("SetPseudonymMigrationMode", strconv.FormatBool(enabled)); err != nil {
+		fc.PrintGatewayError(err)
+		return err
+	}
+	return nil
+}
+
+func migrateIdentity(w *wl.Wallet, builder fc.GWBuilder, sysEntry *wl.Entry, label, dir, oldHash, newHash string) error {
+	// 1. 重新簽發帶 pseudonym 屬性的憑證；以隨機 secret 重新 enroll，不需要使用者密碼
+	secret, err := randomSecret()
+	if err != nil {
+		return err
+	}
+	err = fc.ModifyIdentity(caURL, caAdminCert, caAdminKey, api.ModifyIdentityRequest{
+		ID:     label,
+		Secret: secret,
+		Attributes: []api.Attribute{
+			{Name: "pseudonym", Value: newHash, ECert: true},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	privKey, csrPEM, err := fc.GenerateCSR(label)
+	if err != nil {
+		return err
+	}
+	certPem, err := fc.EnrollUser(caURL, label, secret, fc.EnrollRequest{Certificate_request: string(csrPEM)})
+	if err != nil {
+		return err
+	}
+
+	// 2. 鏈上改寫，分批直到沒有剩餘紀錄
+	if err := migrateLedger(builder, sysEntry, oldHash, newHash); err != nil {
+		return err
+	}
+
+	// 3. 鏈上完成後才替換錢包憑證，避免新憑證對應不到舊紀錄
+	baseDir := filepath.Join("msp-data", dir, label)
+	for _, sub := range []string{"keystore", "signcerts"} {
+		if err := os.MkdirAll(filepath.Join(baseDir, sub), 0700); err != nil {
+			return fmt.Errorf("建立 %s 目錄失敗: %w", sub, err)
+		}
+	}
+	keyPath := filepath.Join(baseDir, "keystore", "key.pem")
+	if err := fc.SavePrivateKeyToFile(privKey, keyPath); err != nil {
+		return err
+	}
+	certPath := filepath.Join(baseDir, "signcerts", "cert.pem")
+	if err := fc.SaveCertToFile(certPem, certPath); err != nil {
+		return err
+	}
+	return w.PutFile(label, certPath, keyPath, "Org1MSP")
+}
+
+// migrateLedger 分批以 ListPseudonymRecords 查出仍帶舊假名的紀錄，再交給 MigratePseudonym 改寫。
+// 新舊假名與鍵值只放在 transient data，對應關係不會寫入區塊；每批都重新查詢，因此中斷後重新執行即可接續。
+// 鏈碼會略過無法辨識的紀錄且不改寫，這些紀錄每批都會再被查到，因此整批都未改寫時停止
+func migrateLedger(builder fc.GWBuilder, sysEntry *wl.Entry, oldHash, newHash string) error {
+	contract, gw, err := builder.NewContract(sysEntry.ID, sysEntry.Signer)
+	if err != nil {
+		return err
+	}
+	defer gw.Close()
+	for {
+		result, err := contract.Evaluate(
+			"ListPseudonymRecords",
+			client.WithArguments(strconv.Itoa(migrateBatch)),
+			client.WithTransient(map[string][]byte{"oldPseudonym": []byte(oldHash)}),
+		)
+		if err != nil {
+			fc.PrintGatewayError(err)
+			return err
+		}
+		var keys []string
+		if err := json.Unmarshal(result, &keys); err != nil {
+			return fmt.Errorf("無法解析鏈碼回應: %w", err)
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		recordKeys, _ := json.Marshal(keys)
+		result, err = contract.Submit(
+			"MigratePseudonym",
+			client.WithTransient(map[string][]byte{
+				"oldPseudonym": []byte(oldHash),
+				"newPseudonym": []byte(newHash),
+				"recordKeys":   recordKeys,
+			}),
+		)
+		if err != nil {
+			fc.PrintGatewayError(err)
+			return err
+		}
+		var batch struct {
+			Scanned  int `json:"scanned"`
+			Migrated int `json:"migrated"`
+		}
+		if err := json.Unmarshal(result, &batch); err != nil {
+			return fmt.Errorf("無法解析鏈碼回應: %w", err)
+		}
+		if batch.Migrated == 0 {
+			log.Printf("⚠️ 本批 %d 筆鏈上紀錄皆無法辨識，停止改寫", batch.Scanned)
+			return nil
+		}
+	}
+}
+
+func randomSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	db "go_server/database"
+	fc "go_server/fabric"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-ca/api"
+)
+
+// 建立平台 system 身份（role=system），供 go_server 排程呼叫鏈碼維護功能
+// 於 go_server 目錄執行：go run ./admin/system
+func main() {
+	err := db.InitDB("database/user_data.sqlite")
+	if err != nil {
+		log.Fatalf("❌ SQLite 初始化失敗: %v", err)
+	}
+	userId := "system"
+	if v := os.Getenv("SYSTEM_IDENTITY"); v != "" {
+		userId = v
+	}
+	password := os.Getenv("SYSTEM_IDENTITY_SECRET")
+	if password == "" {
+		log.Fatalf("請設定 SYSTEM_IDENTITY_SECRET")
+	}
+
+	// ✅ 檢查錢包是否已存在
+	w := wl.New()
+	if w.Exists(userId) {
+		log.Fatalf("此 system 身份已存在: %s", userId)
+	}
+
+	// ✅ Fabric CA 註冊
+	err = fc.RegisterUser(
+		"http://localhost:7054",
+		"../orgs/org1.example.com/users/org1-admin/msp/signcerts/cert.pem",
+		"../orgs/org1.example.com/users/org1-admin/msp/keystore/server.key",
+		api.RegistrationRequest{
+			Name:        userId,
+			Secret:      password,
+			Type:        "client",
+			Affiliation: "org1.department1",
+			Attributes: []api.Attribute{
+				{Name: "role", Value: "system", ECert: true},
+			},
+		},
+	)
+	if err != nil {
+		log.Fatalf("Fabric 註冊失敗: %v", err)
+	}
+	fmt.Println("✅ CA 註冊成功")
+
+	// ✅ 產生 CSR & 金鑰
+	privKey, csrPEM, err := fc.GenerateCSR(userId)
+	if err != nil {
+		log.Fatalf("產生 CSR 失敗: %v", err)
+	}
+
+	baseDir := filepath.Join("msp-data", "system", userId)
+	os.MkdirAll(filepath.Join(baseDir, "keystore"), 0700)
+	os.MkdirAll(filepath.Join(baseDir, "signcerts"), 0700)
+
+	keyPath := filepath.Join(baseDir, "keystore", "key.pem")
+	if err := fc.SavePrivateKeyToFile(privKey, keyPath); err != nil {
+		log.Fatalf("❌ 寫入私鑰失敗: %v", err)
+	}
+
+	// ✅ Enroll（用自己產生的 CSR）
+	certPem, err := fc.EnrollUser("http://localhost:7054", userId, password, fc.EnrollRequest{
+		Certificate_request: string(csrPEM),
+	})
+	if err != nil {
+		log.Fatalf("Enroll 失敗: %v", err)
+	}
+
+	certPath := filepath.Join(baseDir, "signcerts", "cert.pem")
+	if err := fc.SaveCertToFile(certPem, certPath); err != nil {
+		log.Fatalf("❌ 寫入證書失敗: %v", err)
+	}
+
+	// ✅ 寫入 wallet（system 身份不登入，不寫入 users 資料表）
+	if err := w.PutFile(userId, certPath, keyPath, "Org1MSP"); err != nil {
+		log.Fatalf("錢包寫入失敗: %v", err)
+	}
+
+	fmt.Println("🎉 system 身份建立完成！")
+}
@@ -0,0 +1,135 @@
+// Package analyte 提供檢驗項目字典：將各健檢中心的自訂代碼對應到 LOINC 代碼、標準單位與中英文名稱，
+// 讓不同健檢中心上傳的報告可以互相比較
+package analyte
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Analyte 為字典中的單一檢驗項目，Unit 為標準單位（UCUM 寫法）
+type Analyte struct {
+	LOINC  string   `json:"loinc"`
+	Unit   string   `json:"unit"`
+	NameZH string   `json:"nameZh"`
+	NameEN string   `json:"nameEn"`
+	Codes  []string `json:"codes,omitempty"` // 內建對應的常見自訂代碼
+}
+
+// 內建字典，以 LOINC 代碼為鍵
+var dictionary = map[string]Analyte{}
+
+// 內建的自訂代碼對應，健檢中心未自行設定時使用（取自現行報告常見的標籤）
+var defaultAliases = map[string]string{}
+
+func define(loinc, unit, nameZH, nameEN string, aliases ...string) {
+	dictionary[loinc] = Analyte{LOINC: loinc, Unit: unit, NameZH: nameZH, NameEN: nameEN, Codes: aliases}
+	for _, a := range aliases {
+		defaultAliases[aliasKey(a)] = loinc
+	}
+}
+
+func init() {
+	// 血糖
+	define("1558-6", "mg/dL", "空腹血糖", "Fasting glucose", "Glu-AC", "AC Sugar")
+	define("1521-4", "mg/dL", "飯後血糖", "Glucose 2 hours post meal", "Glu-PC", "PC Sugar")
+	define("4548-4", "%", "糖化血色素", "Hemoglobin A1c", "HbA1c")
+	// 肝功能
+	define("1751-7", "g/dL", "白蛋白", "Albumin", "Alb")
+	define("2885-2", "g/dL", "總蛋白", "Total protein", "TP")
+	define("1920-8", "U/L", "天門冬胺酸轉胺酶", "Aspartate aminotransferase", "AST（GOT）", "AST(GOT)", "AST", "GOT")
+	define("1742-6", "U/L", "丙胺酸轉胺酶", "Alanine aminotransferase", "ALT（GPT）", "ALT(GPT)", "ALT", "GPT")
+	define("6768-6", "U/L", "鹼性磷酸酶", "Alkaline phosphatase", "ALP")
+	define("1975-2", "mg/dL", "總膽紅素", "Total bilirubin", "T-Bil")
+	define("1968-7", "mg/dL", "直接膽紅素", "Direct bilirubin", "D-Bil")
+	// 腎功能
+	define("3094-0", "mg/dL", "尿素氮", "Urea nitrogen", "UN", "BUN")
+	define("2160-0", "mg/dL", "肌酸酐", "Creatinine", "CRE", "Cr")
+	define("3084-1", "mg/dL", "尿酸", "Uric acid", "U.A", "UA")
+	// 血脂
+	define("2093-3", "mg/dL", "總膽固醇", "Total cholesterol", "T-CHO", "TC")
+	define("2089-1", "mg/dL", "低密度脂蛋白膽固醇", "LDL cholesterol", "LDL-C", "LDL")
+	define("2085-9", "mg/dL", "高密度脂蛋白膽固醇", "HDL cholesterol", "HDL-C", "HDL")
+	define("2571-8", "mg/dL", "三酸甘油酯", "Triglyceride", "TG")
+	// 血液常規
+	define("718-7", "g/dL", "血色素", "Hemoglobin", "Hb", "Hgb")
+	define("4544-3", "%", "血球容積比", "Hematocrit", "Hct")
+	define("777-3", "10*3/uL", "血小板", "Platelets", "PLT")
+	define("6690-2", "10*3/uL", "白血球", "Leukocytes", "WBC")
+	define("789-8", "10*6/uL", "紅血球", "Erythrocytes", "RBC")
+	define("787-2", "fL", "平均紅血球容積", "MCV", "MCV")
+	define("785-6", "pg", "平均紅血球血色素", "MCH", "MCH")
+	define("786-4", "g/dL", "平均紅血球血色素濃度", "MCHC", "MCHC")
+	define("788-0", "%", "紅血球分布寬度", "RDW-CV", "RDW-CV", "RDW")
+	define("4537-7", "mm/h", "紅血球沉降速率", "Erythrocyte sedimentation rate", "ESR")
+	// 凝血
+	define("5902-2", "s", "凝血酶原時間", "Prothrombin time", "PT")
+	define("3173-2", "s", "活化部分凝血活酶時間", "aPTT", "aPTT", "APTT")
+	// 發炎與腫瘤標記
+	define("30522-7", "mg/L", "高敏感度C反應蛋白", "hs-CRP", "hsCRP", "hs-CRP")
+	define("1834-1", "ng/mL", "甲型胎兒蛋白", "Alpha-fetoprotein", "AFP")
+	define("2039-6", "ng/mL", "癌胚抗原", "Carcinoembryonic antigen", "CEA")
+	define("10334-1", "U/mL", "癌抗原125", "Cancer antigen 125", "CA-125", "CA125")
+	define("24108-3", "U/mL", "癌抗原19-9", "Cancer antigen 19-9", "CA19-9", "CA 19-9")
+	// 生命徵象（血壓分為收縮壓與舒張壓兩個項目）
+	define("8480-6", "mm[Hg]", "收縮壓", "Systolic blood pressure", "SBP", "BP-SYS")
+	define("8462-4", "mm[Hg]", "舒張壓", "Diastolic blood pressure", "DBP", "BP-DIA")
+	// 尿液
+	define("5811-5", "1", "尿比重", "Specific gravity of urine", "Specific Gravity", "SG")
+	define("5803-2", "[pH]", "尿液酸鹼值", "pH of urine", "PH", "pH")
+	define("5804-0", "mg/dL", "尿蛋白（試紙）", "Protein in urine by test strip", "Protein (Dipstick)")
+	define("5792-7", "mg/dL", "尿糖（試紙）", "Glucose in urine by test strip", "Glucose (Dipstick)")
+	define("5818-0", "mg/dL", "尿膽素原（試紙）", "Urobilinogen in urine by test strip", "Urobilinogen (Dipstick)")
+	define("13945-1", "/[HPF]", "尿沉渣紅血球", "Erythrocytes in urine sediment", "RBC (Urine)")
+	define("5821-4", "/[HPF]", "尿沉渣白血球", "Leukocytes in urine sediment", "WBC (Urine)")
+}
+
+// 自訂代碼比對時忽略大小寫與前後空白
+func aliasKey(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// Lookup 依 LOINC 代碼查詢內建字典
+func Lookup(loinc string) (Analyte, bool) {
+	a, ok := dictionary[loinc]
+	return a, ok
+}
+
+// Dictionary 回傳內建字典，依 LOINC 代碼排序
+func Dictionary() []Analyte {
+	out := make([]Analyte, 0, len(dictionary))
+	for _, a := range dictionary {
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].LOINC < out[j].LOINC })
+	return out
+}
+
+// ValidLOINC 檢查 LOINC 代碼格式與檢查碼（mod 10）
+func ValidLOINC(code string) bool {
+	parts := strings.Split(code, "-")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[0]) > 7 || len(parts[1]) != 1 {
+		return false
+	}
+	check, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	sum := 0
+	for i := len(parts[0]) - 1; i >= 0; i-- {
+		d := int(parts[0][i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		// 由右往左，奇數位數乘 2
+		if (len(parts[0])-1-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == check
+}
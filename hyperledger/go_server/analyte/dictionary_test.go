@@ -0,0 +1,42 @@
+package analyte
+
+import "testing"
+
+func TestValidLOINC(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"1558-6", true},
+		{"2093-3", true},
+		{"718-7", true},
+		{"13945-1", true},
+		{"4548-4", true},
+		{"1558-5", false}, // 檢查碼錯誤
+		{"2093-0", false},
+		{"1558", false},
+		{"1558-66", false},
+		{"-6", false},
+		{"12345678-9", false}, // 超過 7 位數
+		{"15a8-6", false},
+		{"1558-x", false},
+		{"", false},
+		{" 1558-6", false},
+	}
+	for _, tt := range tests {
+		if got := ValidLOINC(tt.code); got != tt.want {
+			t.Errorf("ValidLOINC(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestDictionaryCodesAreValid(t *testing.T) {
+	for _, a := range Dictionary() {
+		if !ValidLOINC(a.LOINC) {
+			t.Errorf("dictionary entry %s has an invalid check digit", a.LOINC)
+		}
+		if a.Unit == "" || a.NameZH == "" || a.NameEN == "" {
+			t.Errorf("dictionary entry %s is incomplete: %+v", a.LOINC, a)
+		}
+	}
+}
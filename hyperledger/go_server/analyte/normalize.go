@@ -0,0 +1,247 @@
+package analyte
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Result 對應鏈碼 LabResult 結構（schemaVersion 2）；Loinc 以下欄位由正規化填入
+type Result struct {
+	Value          *float64 `json:"value,omitempty"`
+	Text           string   `json:"text,omitempty"` // 定性結果，僅限 Flag 為 A，此時不提供 Value
+	Unit           string   `json:"unit,omitempty"`
+	ReferenceRange *Range   `json:"referenceRange"`
+	Flag           string   `json:"flag"`
+	Specimen       string   `json:"specimen,omitempty"`
+	Method         string   `json:"method,omitempty"`
+	Loinc          string   `json:"loinc,omitempty"`
+	LocalCode      string   `json:"localCode,omitempty"` // 健檢中心原始代碼
+	LocalUnit      string   `json:"localUnit,omitempty"` // 換算為標準單位前的原始單位
+	NameZH         string   `json:"nameZh,omitempty"`
+	NameEN         string   `json:"nameEn,omitempty"`
+}
+
+// Range 參考範圍，可只有單邊界限；無法以數值表示時使用 Text
+type Range struct {
+	Low  *float64 `json:"low,omitempty"`
+	High *float64 `json:"high,omitempty"`
+	Text string   `json:"text,omitempty"`
+}
+
+// Fields 為 Result 允許的 JSON 欄位
+var Fields = []string{"value", "text", "unit", "referenceRange", "flag", "specimen", "method", "loinc", "localCode", "localUnit", "nameZh", "nameEn"}
+
+// Mapping 健檢中心自訂代碼的對應設定；LOINC 不在內建字典時需自行提供 Unit 與中英文名稱。
+// 健檢中心使用的單位與標準單位不同且無法自動換算時（例如 mmol/L 換算 mg/dL），以 LocalUnit 與 Factor 指定
+type Mapping struct {
+	LocalCode string
+	LOINC     string
+	Unit      string
+	NameZH    string
+	NameEN    string
+	LocalUnit string
+	Factor    float64 // 標準單位數值 = 原始數值 × Factor
+}
+
+// Violation 為正規化時單一欄位的錯誤
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Table 為單一健檢中心的對應表，未設定的代碼使用內建對應
+type Table struct {
+	mappings map[string]Mapping
+}
+
+// NewTable 建立對應表
+func NewTable(mappings []Mapping) *Table {
+	t := &Table{mappings: make(map[string]Mapping, len(mappings))}
+	for _, m := range mappings {
+		t.mappings[aliasKey(m.LocalCode)] = m
+	}
+	return t
+}
+
+// ValidateMapping 檢查對應設定，回傳的 Mapping 已補上字典中的標準單位與名稱
+func ValidateMapping(m Mapping) (Mapping, error) {
+	m.LocalCode = strings.TrimSpace(m.LocalCode)
+	m.LOINC = strings.TrimSpace(m.LOINC)
+	if m.LocalCode == "" {
+		return m, fmt.Errorf("必須提供自訂代碼")
+	}
+	if !ValidLOINC(m.LOINC) {
+		return m, fmt.Errorf("LOINC 代碼 %s 格式或檢查碼錯誤", m.LOINC)
+	}
+	if a, ok := Lookup(m.LOINC); ok {
+		if m.Unit != "" && !sameUnit(m.Unit, a.Unit) {
+			return m, fmt.Errorf("%s 的標準單位為 %s", m.LOINC, a.Unit)
+		}
+		m.Unit = a.Unit
+		if m.NameZH == "" {
+			m.NameZH = a.NameZH
+		}
+		if m.NameEN == "" {
+			m.NameEN = a.NameEN
+		}
+	} else if m.Unit == "" || m.NameZH == "" || m.NameEN == "" {
+		return m, fmt.Errorf("%s 不在內建字典，必須提供標準單位與中英文名稱", m.LOINC)
+	}
+	if (m.LocalUnit == "") != (m.Factor == 0) {
+		return m, fmt.Errorf("原始單位與換算倍數必須同時提供")
+	}
+	if m.Factor < 0 || math.IsNaN(m.Factor) || math.IsInf(m.Factor, 0) {
+		return m, fmt.Errorf("換算倍數必須為正數")
+	}
+	return m, nil
+}
+
+// resolve 依序以健檢中心對應、結果內已標註的 LOINC、以 LOINC 為鍵的項目、內建對應找出檢驗項目
+func (t *Table) resolve(code string, r Result) (Analyte, *Mapping, bool) {
+	if m, ok := t.mappings[aliasKey(code)]; ok {
+		return Analyte{LOINC: m.LOINC, Unit: m.Unit, NameZH: m.NameZH, NameEN: m.NameEN}, &m, true
+	}
+	if a, ok := Lookup(r.Loinc); ok {
+		return a, nil, true
+	}
+	if a, ok := Lookup(code); ok {
+		return a, nil, true
+	}
+	if loinc, ok := defaultAliases[aliasKey(code)]; ok {
+		return dictionary[loinc], nil, true
+	}
+	return Analyte{}, nil, false
+}
+
+// Normalize 將以自訂代碼為鍵的檢驗結果改為以 LOINC 代碼為鍵，並換算為標準單位，
+// 原始代碼保留於 localCode。對應不到的項目維持原代碼，並列於 unmapped
+func (t *Table) Normalize(resultJSON string) (normalized string, unmapped []string, violations []Violation, err error) {
+	var all map[string]Result
+	if err := json.Unmarshal([]byte(resultJSON), &all); err != nil {
+		return "", nil, nil, fmt.Errorf("無法解析檢驗結果: %v", err)
+	}
+	codes := make([]string, 0, len(all))
+	for code := range all {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	out := make(map[string]Result, len(all))
+	source := make(map[string]string, len(all))
+	for _, code := range codes {
+		r := all[code]
+		a, m, ok := t.resolve(code, r)
+		if !ok {
+			unmapped = append(unmapped, code)
+			if prev, dup := source[code]; dup {
+				violations = append(violations, Violation{code, fmt.Sprintf("與 %s 的 LOINC 代碼相同", prev)})
+				continue
+			}
+			out[code], source[code] = r, code
+			continue
+		}
+
+		if r.LocalCode == "" && code != a.LOINC {
+			r.LocalCode = code
+		}
+		// 定性結果沒有數值可換算，保留原單位
+		if r.Value != nil && !sameUnit(r.Unit, a.Unit) {
+			factor, ok := 0.0, false
+			if m != nil && m.Factor > 0 && sameUnit(r.Unit, m.LocalUnit) {
+				factor, ok = m.Factor, true
+			} else {
+				factor, ok = conversionFactor(r.Unit, a.Unit)
+			}
+			if !ok {
+				violations = append(violations, Violation{code + ".unit", fmt.Sprintf("無法將 %s 換算為標準單位 %s，請在對應表設定原始單位與換算倍數", r.Unit, a.Unit)})
+				continue
+			}
+			r.Value = scale(r.Value, factor)
+			if r.ReferenceRange != nil {
+				r.ReferenceRange.Low = scale(r.ReferenceRange.Low, factor)
+				r.ReferenceRange.High = scale(r.ReferenceRange.High, factor)
+			}
+			if r.LocalUnit == "" {
+				r.LocalUnit = r.Unit
+			}
+		}
+		if r.Value != nil {
+			r.Unit = a.Unit
+		}
+		r.Loinc = a.LOINC
+		r.NameZH = a.NameZH
+		r.NameEN = a.NameEN
+
+		if prev, dup := source[a.LOINC]; dup {
+			violations = append(violations, Violation{code, fmt.Sprintf("與 %s 對應到相同的 LOINC 代碼 %s", prev, a.LOINC)})
+			continue
+		}
+		out[a.LOINC], source[a.LOINC] = r, code
+	}
+	if len(violations) > 0 {
+		return "", unmapped, violations, nil
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("無法產生檢驗結果: %v", err)
+	}
+	return string(b), unmapped, nil, nil
+}
+
+func scale(v *float64, factor float64) *float64 {
+	if v == nil {
+		return nil
+	}
+	// 四捨五入去除浮點誤差，避免 0.38*10 變成 3.8000000000000003
+	out := math.Round(*v*factor*1e9) / 1e9
+	return &out
+}
+
+// 同一單位的常見寫法（比對時忽略大小寫與空白）
+var unitSynonyms = map[string]string{
+	"x10^3/ul": "10*3/ul", "10^3/ul": "10*3/ul", "k/ul": "10*3/ul", "10^9/l": "10*3/ul",
+	"x10^6/ul": "10*6/ul", "10^6/ul": "10*6/ul", "m/ul": "10*6/ul", "10^12/l": "10*6/ul",
+	"mmhg": "mm[hg]",
+	"sec":  "s", "secs": "s", "seconds": "s",
+	"mm/hr": "mm/h",
+	"/hpf":  "/[hpf]", "/lpf": "/[lpf]",
+	"ph": "[ph]",
+}
+
+// 質量濃度單位換算為 g/L 的倍數，與檢驗項目無關，可直接換算
+var massConcentration = map[string]float64{
+	"g/l":   1,
+	"g/dl":  10,
+	"mg/dl": 0.01,
+	"mg/l":  0.001,
+	"ug/ml": 0.001,
+	"ug/dl": 0.00001,
+	"ng/ml": 0.000001,
+}
+
+func canonicalUnit(u string) string {
+	u = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(u), " ", ""))
+	u = strings.ReplaceAll(u, "μ", "u")
+	if s, ok := unitSynonyms[u]; ok {
+		return s
+	}
+	return u
+}
+
+func sameUnit(a, b string) bool {
+	return canonicalUnit(a) == canonicalUnit(b)
+}
+
+// conversionFactor 回傳由 from 換算為 to 的倍數，只支援質量濃度單位之間的換算
+func conversionFactor(from, to string) (float64, bool) {
+	f, ok1 := massConcentration[canonicalUnit(from)]
+	t, ok2 := massConcentration[canonicalUnit(to)]
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return f / t, true
+}
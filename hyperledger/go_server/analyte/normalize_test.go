@@ -0,0 +1,169 @@
+package analyte
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	type want struct {
+		value     *float64
+		unit      string
+		localCode string
+		localUnit string
+		low, high *float64
+	}
+	num := func(v float64) *float64 { return &v }
+	glucoseMmol := Mapping{LocalCode: "GLU", LOINC: "1558-6", LocalUnit: "mmol/L", Factor: 18}
+
+	tests := []struct {
+		name           string
+		mappings       []Mapping
+		input          string
+		want           map[string]want
+		wantUnmapped   []string
+		wantViolations []string
+	}{
+		{
+			name:  "default alias is re-keyed by LOINC",
+			input: `{"Glu-AC": {"value": 89, "unit": "mg/dL", "referenceRange": {"low": 70, "high": 99}, "flag": "N"}}`,
+			want:  map[string]want{"1558-6": {value: num(89), unit: "mg/dL", localCode: "Glu-AC", low: num(70), high: num(99)}},
+		},
+		{
+			name:  "mass concentration is converted",
+			input: `{"CRE": {"value": 12, "unit": "mg/L", "referenceRange": {"low": 7, "high": 13}, "flag": "N"}}`,
+			want:  map[string]want{"2160-0": {value: num(1.2), unit: "mg/dL", localCode: "CRE", localUnit: "mg/L", low: num(0.7), high: num(1.3)}},
+		},
+		{
+			name:  "unit synonym needs no conversion",
+			input: `{"PLT": {"value": 286, "unit": "x10^3/uL", "referenceRange": {"low": 150, "high": 400}, "flag": "N"}}`,
+			want:  map[string]want{"777-3": {value: num(286), unit: "10*3/uL", localCode: "PLT", low: num(150), high: num(400)}},
+		},
+		{
+			name:           "molar unit without mapping factor",
+			input:          `{"Glu-AC": {"value": 5, "unit": "mmol/L", "referenceRange": {"low": 3.9, "high": 5.5}, "flag": "N"}}`,
+			wantViolations: []string{"Glu-AC.unit"},
+		},
+		{
+			name:     "clinic mapping supplies the factor",
+			mappings: []Mapping{glucoseMmol},
+			input:    `{"GLU": {"value": 5, "unit": "mmol/L", "referenceRange": {"low": 3.9, "high": 5.5}, "flag": "N"}}`,
+			want:     map[string]want{"1558-6": {value: num(90), unit: "mg/dL", localCode: "GLU", localUnit: "mmol/L", low: num(70.2), high: num(99)}},
+		},
+		{
+			name:  "LOINC key is kept without localCode",
+			input: `{"2093-3": {"value": 164, "unit": "mg/dL", "referenceRange": {"high": 200}, "flag": "N"}}`,
+			want:  map[string]want{"2093-3": {value: num(164), unit: "mg/dL", high: num(200)}},
+		},
+		{
+			name:  "qualitative result keeps its unit",
+			input: `{"Glu-AC": {"text": "-", "referenceRange": {"text": "-"}, "flag": "A"}}`,
+			want:  map[string]want{"1558-6": {localCode: "Glu-AC"}},
+		},
+		{
+			name:         "unknown code stays under its label",
+			input:        `{"XYZ": {"value": 1, "unit": "U", "referenceRange": {"high": 2}, "flag": "N"}}`,
+			want:         map[string]want{"XYZ": {value: num(1), unit: "U", high: num(2)}},
+			wantUnmapped: []string{"XYZ"},
+		},
+		{
+			name: "two codes for the same LOINC",
+			input: `{"Glu-AC": {"value": 89, "unit": "mg/dL", "referenceRange": {"high": 99}, "flag": "N"},
+				"AC Sugar": {"value": 90, "unit": "mg/dL", "referenceRange": {"high": 99}, "flag": "N"}}`,
+			wantViolations: []string{"Glu-AC"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mappings []Mapping
+			for _, m := range tt.mappings {
+				v, err := ValidateMapping(m)
+				if err != nil {
+					t.Fatalf("ValidateMapping: %v", err)
+				}
+				mappings = append(mappings, v)
+			}
+			normalized, unmapped, violations, err := NewTable(mappings).Normalize(tt.input)
+			if err != nil {
+				t.Fatalf("Normalize: %v", err)
+			}
+			if !reflect.DeepEqual(unmapped, tt.wantUnmapped) {
+				t.Errorf("unmapped = %v, want %v", unmapped, tt.wantUnmapped)
+			}
+			var fields []string
+			for _, v := range violations {
+				fields = append(fields, v.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantViolations) {
+				t.Fatalf("violations = %+v, want fields %v", violations, tt.wantViolations)
+			}
+			if len(tt.wantViolations) > 0 {
+				return
+			}
+
+			var got map[string]Result
+			if err := json.Unmarshal([]byte(normalized), &got); err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("normalized = %s", normalized)
+			}
+			for key, w := range tt.want {
+				r, ok := got[key]
+				if !ok {
+					t.Fatalf("missing %s in %s", key, normalized)
+				}
+				if !sameFloat(r.Value, w.value) || r.Unit != w.unit || r.LocalCode != w.localCode || r.LocalUnit != w.localUnit {
+					t.Errorf("%s = %+v, want %+v", key, r, w)
+				}
+				if r.ReferenceRange == nil || !sameFloat(r.ReferenceRange.Low, w.low) || !sameFloat(r.ReferenceRange.High, w.high) {
+					t.Errorf("%s.referenceRange = %+v, want low %v high %v", key, r.ReferenceRange, w.low, w.high)
+				}
+			}
+		})
+	}
+}
+
+func sameFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func TestValidateMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Mapping
+		wantErr string
+	}{
+		{"dictionary entry", Mapping{LocalCode: "GLU", LOINC: "1558-6"}, ""},
+		{"missing local code", Mapping{LOINC: "1558-6"}, "必須提供自訂代碼"},
+		{"bad check digit", Mapping{LocalCode: "GLU", LOINC: "1558-5"}, "檢查碼錯誤"},
+		{"unit differs from dictionary", Mapping{LocalCode: "GLU", LOINC: "1558-6", Unit: "mmol/L"}, "標準單位為 mg/dL"},
+		{"unit synonym accepted", Mapping{LocalCode: "PLT", LOINC: "777-3", Unit: "K/uL"}, ""},
+		{"unknown LOINC without names", Mapping{LocalCode: "X", LOINC: "2345-7"}, "不在內建字典"},
+		{"unknown LOINC with names", Mapping{LocalCode: "X", LOINC: "2345-7", Unit: "mg/dL", NameZH: "血糖", NameEN: "Glucose"}, ""},
+		{"factor without local unit", Mapping{LocalCode: "GLU", LOINC: "1558-6", Factor: 18}, "必須同時提供"},
+		{"negative factor", Mapping{LocalCode: "GLU", LOINC: "1558-6", LocalUnit: "mmol/L", Factor: -18}, "必須為正數"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ValidateMapping(tt.m)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if m.Unit == "" || m.NameZH == "" || m.NameEN == "" {
+					t.Errorf("mapping not completed: %+v", m)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// AnalyteMapping 健檢中心自訂檢驗代碼與 LOINC 代碼的對應
+type AnalyteMapping struct {
+	ClinicID  string
+	LocalCode string
+	LOINC     string
+	Unit      string
+	NameZH    string
+	NameEN    string
+	LocalUnit string
+	Factor    float64
+	UpdatedAt int64
+}
+
+// initAnalyteMappings 建立健檢中心檢驗代碼對應表
+func initAnalyteMappings() error {
+	_, err := DB.Exec(`
+	CREATE TABLE IF NOT EXISTS analyte_mappings (
+		clinic_id TEXT,
+		local_code TEXT,
+		loinc TEXT,
+		unit TEXT,
+		name_zh TEXT,
+		name_en TEXT,
+		local_unit TEXT,
+		factor REAL,
+		updated_at INTEGER,
+		PRIMARY KEY (clinic_id, local_code)
+	);`)
+	if err != nil {
+		return fmt.Errorf("建立檢驗代碼對應表失敗: %v", err)
+	}
+	return nil
+}
+
+// 新增或更新對應
+func UpsertAnalyteMapping(m AnalyteMapping) error {
+	_, err := DB.Exec(`INSERT INTO analyte_mappings(clinic_id, local_code, loinc, unit, name_zh, name_en, local_unit, factor, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(clinic_id, local_code) DO UPDATE SET
+			loinc = excluded.loinc, unit = excluded.unit, name_zh = excluded.name_zh, name_en = excluded.name_en,
+			local_unit = excluded.local_unit, factor = excluded.factor, updated_at = excluded.updated_at`,
+		m.ClinicID, m.LocalCode, m.LOINC, m.Unit, m.NameZH, m.NameEN, m.LocalUnit, m.Factor, time.Now().Unix())
+	return err
+}
+
+// 刪除對應，回傳是否有資料被刪除
+func DeleteAnalyteMapping(clinicID, localCode string) (bool, error) {
+	res, err := DB.Exec("DELETE FROM analyte_mappings WHERE clinic_id = ? AND local_code = ?", clinicID, localCode)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// 列出健檢中心的所有對應
+func ListAnalyteMappings(clinicID string) ([]AnalyteMapping, error) {
+	rows, err := DB.Query(`SELECT clinic_id, local_code, loinc, unit, name_zh, name_en, local_unit, factor, updated_at
+		FROM analyte_mappings WHERE clinic_id = ? ORDER BY local_code`, clinicID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AnalyteMapping
+	for rows.Next() {
+		var m AnalyteMapping
+		if err := rows.Scan(&m.ClinicID, &m.LocalCode, &m.LOINC, &m.Unit, &m.NameZH, &m.NameEN, &m.LocalUnit, &m.Factor, &m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
@@ -57,6 +57,43 @@ func InitDB(path string) error {
 		return fmt.Errorf("建立保險業者資料表失敗: %v", err)
 	}
 
+	// 平台管理者表（維護健檢中心與保險業者登錄資料）
+	createAdminStmt := `
+	CREATE TABLE IF NOT EXISTS admins (
+		admin_id TEXT PRIMARY KEY,
+		password TEXT,
+		name TEXT
+	);`
+
+	_, err = DB.Exec(createAdminStmt)
+	if err != nil {
+		return fmt.Errorf("建立管理者資料表失敗: %v", err)
+	}
+
+	// 急診人員表（可使用緊急存取）
+	createEmergencyStmt := `
+	CREATE TABLE IF NOT EXISTS emergency_staff (
+		staff_id TEXT PRIMARY KEY,
+		password TEXT,
+		name TEXT,
+		facility TEXT
+	);`
+
+	_, err = DB.Exec(createEmergencyStmt)
+	if err != nil {
+		return fmt.Errorf("建立急診人員資料表失敗: %v", err)
+	}
+
+	// 鏈上假名
+	if err := initPseudonym(); err != nil {
+		return err
+	}
+
+	// 健檢中心檢驗代碼對應表
+	if err := initAnalyteMappings(); err != nil {
+		return err
+	}
+
 	log.Println("✅ SQLite 初始化成功")
 	return nil
 }
@@ -77,13 +114,61 @@ func IsInsurerExists(insurerId string) (bool, error) {
 	return count > 0, err
 }
 
+// 查詢管理者帳號是否存在
+func IsAdminExists(adminId string) (bool, error) {
+	var count int
+	err := DB.QueryRow("SELECT COUNT(*) FROM admins WHERE admin_id = ?", HashString(adminId)).Scan(&count)
+	return count > 0, err
+}
+
+// 新增管理者
+func InsertAdmin(adminId, password, name string) error {
+	_, err := DB.Exec("INSERT INTO admins(admin_id, password, name) VALUES (?, ?, ?)",
+		HashString(adminId), HashString(password), name)
+	return err
+}
+
+// 取得管理者密碼
+func GetAdminPassword(adminId string) (string, error) {
+	var password string
+	err := DB.QueryRow("SELECT password FROM admins WHERE admin_id = ?", HashString(adminId)).Scan(&password)
+	if err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+// 查詢急診人員帳號是否存在
+func IsEmergencyStaffExists(staffId string) (bool, error) {
+	var count int
+	err := DB.QueryRow("SELECT COUNT(*) FROM emergency_staff WHERE staff_id = ?", HashString(staffId)).Scan(&count)
+	return count > 0, err
+}
+
+// 新增急診人員
+func InsertEmergencyStaff(staffId, password, name, facility string) error {
+	_, err := DB.Exec("INSERT INTO emergency_staff(staff_id, password, name, facility) VALUES (?, ?, ?, ?)",
+		HashString(staffId), HashString(password), name, facility)
+	return err
+}
+
+// 取得急診人員密碼
+func GetEmergencyStaffPassword(staffId string) (string, error) {
+	var password string
+	err := DB.QueryRow("SELECT password FROM emergency_staff WHERE staff_id = ?", HashString(staffId)).Scan(&password)
+	if err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
 // 新增用戶
 func InsertUser(username, password, name, date, email, phone string) error {
 	log.Printf("[Debug] 新增用戶: %s", username)
 	hashedUsername := HashString(username)
 	hashedPassword := HashString(password)
-	_, err := DB.Exec("INSERT INTO users(username, password, name, date, email, phone) VALUES (?, ?, ?, ?, ?, ?)",
-		hashedUsername, hashedPassword, name, date, email, phone)
+	_, err := DB.Exec("INSERT INTO users(username, password, name, date, email, phone, pseudonym) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		hashedUsername, hashedPassword, name, date, email, phone, Pseudonym(username))
 	return err
 }
 
@@ -92,8 +177,8 @@ func InsertInsurer(insurerId, password, companyName, contactPerson, email, phone
 	log.Printf("[Debug] 新增保險業者: %s", insurerId)
 	hashedInsurerId := HashString(insurerId)
 	hashedPassword := HashString(password)
-	_, err := DB.Exec("INSERT INTO insurers(insurer_id, password, company_name, contact_person, email, phone) VALUES (?, ?, ?, ?, ?, ?)",
-		hashedInsurerId, hashedPassword, companyName, contactPerson, email, phone)
+	_, err := DB.Exec("INSERT INTO insurers(insurer_id, password, company_name, contact_person, email, phone, pseudonym) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		hashedInsurerId, hashedPassword, companyName, contactPerson, email, phone, Pseudonym(insurerId))
 	return err
 }
 
@@ -137,13 +222,13 @@ type UserInfo struct {
 	Phone    string
 }
 
-// GetInsurerByHash 根據雜湊值獲取保險業者資訊
+// GetInsurerByHash 根據鏈上假名（新 HMAC 假名或舊 SHA-256）獲取保險業者資訊
 func GetInsurerByHash(insurerHash string) (*InsurerInfo, error) {
 	var info InsurerInfo
 	err := DB.QueryRow(`
 		SELECT insurer_id, company_name, contact_person, email, phone 
 		FROM insurers 
-		WHERE insurer_id = ?`, insurerHash).Scan(
+		WHERE pseudonym = ? OR insurer_id = ?`, insurerHash, insurerHash).Scan(
 		&info.InsurerID,
 		&info.CompanyName,
 		&info.Name,
@@ -159,13 +244,13 @@ func GetInsurerByHash(insurerHash string) (*InsurerInfo, error) {
 	return &info, nil
 }
 
-// GetUserByHash 根據雜湊值獲取用戶資訊
+// GetUserByHash 根據鏈上假名（新 HMAC 假名或舊 SHA-256）獲取用戶資訊
 func GetUserByHash(userHash string) (*UserInfo, error) {
 	var info UserInfo
 	err := DB.QueryRow(`
 		SELECT username, name, date, email, phone 
 		FROM users 
-		WHERE username = ?`, userHash).Scan(
+		WHERE pseudonym = ? OR username = ?`, userHash, userHash).Scan(
 		&info.Username,
 		&info.Name,
 		&info.Date,
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// 產生鏈上假名用的金鑰，只存在於伺服器端（環境變數 PSEUDONYM_KEY）
+var pseudonymKey []byte
+
+// initPseudonym 讀取假名金鑰並為 users / insurers 資料表加上 pseudonym 欄位；
+// 未設定金鑰時回傳錯誤，不以未加鹽的 SHA-256 產生新假名
+func initPseudonym() error {
+	key := os.Getenv("PSEUDONYM_KEY")
+	if key == "" {
+		return fmt.Errorf("未設定 PSEUDONYM_KEY，無法產生鏈上假名")
+	}
+	pseudonymKey = []byte(key)
+	for _, table := range []string{"users", "insurers"} {
+		if err := ensureColumn(table, "pseudonym", "TEXT"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureColumn 欄位不存在時新增（舊資料庫升級用）
+func ensureColumn(table, column, colType string) error {
+	rows, err := DB.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("讀取 %s 資料表結構失敗: %v", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			ctype     string
+			notnull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &ctype, &notnull, &dfltValue, &pk); err != nil {
+			return err
+		}
+		if strings.EqualFold(name, column) {
+			return nil
+		}
+	}
+	rows.Close()
+	_, err = DB.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, colType))
+	if err != nil {
+		return fmt.Errorf("新增 %s.%s 欄位失敗: %v", table, column, err)
+	}
+	return nil
+}
+
+// Pseudonym 以 HMAC-SHA256(PSEUDONYM_KEY, id) 產生鏈上假名，金鑰於 InitDB 時載入
+func Pseudonym(id string) string {
+	mac := hmac.New(sha256.New, pseudonymKey)
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// resolveHash 依資料表中的 pseudonym 欄位取得目前使用的鏈上假名：
+// 已遷移者回傳 pseudonym，尚未遷移的舊帳號回傳 SHA-256，未註冊者回傳新假名
+func resolveHash(table, idColumn, id string) string {
+	var pseudonym sql.NullString
+	err := DB.QueryRow(
+		fmt.Sprintf("SELECT pseudonym FROM %s WHERE %s = ?", table, idColumn),
+		HashString(id)).Scan(&pseudonym)
+	if err == sql.ErrNoRows {
+		return Pseudonym(id)
+	}
+	if err != nil || !pseudonym.Valid || pseudonym.String == "" {
+		return HashString(id)
+	}
+	return pseudonym.String
+}
+
+// ResolveUserHash 取得病患目前在鏈上使用的假名
+func ResolveUserHash(userID string) string {
+	return resolveHash("users", "username", userID)
+}
+
+// ResolveInsurerHash 取得保險業者目前在鏈上使用的假名
+func ResolveInsurerHash(insurerID string) string {
+	return resolveHash("insurers", "insurer_id", insurerID)
+}
+
+// SetUserPseudonym 假名遷移完成後更新病患的 pseudonym
+func SetUserPseudonym(userID, pseudonym string) error {
+	_, err := DB.Exec("UPDATE users SET pseudonym = ? WHERE username = ?", pseudonym, HashString(userID))
+	return err
+}
+
+// SetInsurerPseudonym 假名遷移完成後更新保險業者的 pseudonym
+func SetInsurerPseudonym(insurerID, pseudonym string) error {
+	_, err := DB.Exec("UPDATE insurers SET pseudonym = ? WHERE insurer_id = ?", pseudonym, HashString(insurerID))
+	return err
+}
@@ -43,3 +43,16 @@ func PrintGatewayError(err error) {
 		}
 	}
 }
+
+// ChaincodeMessage 取出 endorsement 失敗時鏈碼回傳的錯誤訊息，沒有細節時回傳原始錯誤訊息
+func ChaincodeMessage(err error) string {
+	if s, ok := status.FromError(err); ok {
+		for _, d := range s.Details() {
+			if det, ok := d.(*gateway.ErrorDetail); ok && det.Message != "" {
+				return det.Message
+			}
+		}
+		return s.Message()
+	}
+	return err.Error()
+}
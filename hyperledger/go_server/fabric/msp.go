@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
@@ -126,6 +127,60 @@ func RegisterUser(caURL, certPath, keyPath string, req api.RegistrationRequest)
 	return nil
 }
 
+// 使用 Fabric 官方 api.ModifyIdentityRequest 結構修改既有身份（屬性、enrollment secret）
+func ModifyIdentity(caURL, certPath, keyPath string, req api.ModifyIdentityRequest) error {
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to marshal request: %w", err)
+	}
+
+	// 讀取 admin 的證書與私鑰，用於產生 token
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to read cert: %w", err)
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to read key: %w", err)
+	}
+
+	factory.InitFactories(nil)
+	csp := factory.GetDefault()
+
+	keyBlock, _ := pem.Decode(keyPEM)
+	key, err := csp.KeyImport(keyBlock.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+	if err != nil {
+		return fmt.Errorf("❌ Failed to import key: %w", err)
+	}
+
+	endpoint := caURL + "/api/v1/identities/" + url.PathEscape(req.ID)
+	token, err := GenECDSAToken(csp, certPEM, key, "PUT", endpoint, bodyBytes)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to generate token: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("PUT", endpoint, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return fmt.Errorf("❌ Failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", token)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return fmt.Errorf("❌ Modify identity failed (%d): %s", resp.StatusCode, respBody)
+	}
+
+	fmt.Printf("✅ Modify identity success: %s\n", req.ID)
+	return nil
+}
+
 func EnrollUser(caURL, enrollID, enrollSecret string, enrollRequest EnrollRequest) ([]byte, error) {
 
 	var enrollResp struct {
@@ -205,3 +260,31 @@ func SaveCSRToFile(csrPEM []byte, filename string) error {
 func SaveCertToFile(certPEM []byte, filename string) error {
 	return ioutil.WriteFile(filename, certPEM, 0600)
 }
+
+// Fabric CA 將 ECert 屬性以 JSON 寫入此 extension
+var attrsOID = asn1.ObjectIdentifier{1, 2, 3, 4, 5, 6, 7, 8, 1}
+
+// CertAttributes 讀取憑證上由 Fabric CA 寫入的屬性（role、clinicId 等）
+func CertAttributes(certPEM []byte) (map[string]string, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid certificate PEM")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(attrsOID) {
+			continue
+		}
+		var attrs struct {
+			Attrs map[string]string `json:"attrs"`
+		}
+		if err := json.Unmarshal(ext.Value, &attrs); err != nil {
+			return nil, err
+		}
+		return attrs.Attrs, nil
+	}
+	return map[string]string{}, nil
+}
@@ -0,0 +1,300 @@
+package fhir
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go_server/analyte"
+)
+
+// Report 為匯出所需的報告內容，ResultJSON 為鏈碼讀取後（可能已依授權欄位遮蔽）的內容
+type Report struct {
+	ReportID      string
+	ClinicID      string
+	ClinicName    string
+	PatientHash   string
+	Version       int32
+	SchemaVersion int32
+	CreatedAt     int64
+	AmendedAt     int64
+	ResultJSON    string
+}
+
+var interpretationDisplay = map[string]string{
+	"N":  "Normal",
+	"L":  "Low",
+	"H":  "High",
+	"LL": "Critical low",
+	"HH": "Critical high",
+	"A":  "Abnormal",
+}
+
+// BuildBundle 將報告轉為 FHIR R4 collection Bundle：一個 DiagnosticReport、每個檢驗項目一個 Observation，
+// 病患只以假名識別
+func BuildBundle(r Report, now time.Time) ([]byte, error) {
+	patientURL := resourceURL("Patient", r.PatientHash)
+	subject := Reference{Reference: patientURL}
+	effective := instant(r.CreatedAt)
+	issued := effective
+	if r.AmendedAt > 0 {
+		issued = instant(r.AmendedAt)
+	}
+	status := "final"
+	if r.Version > 1 {
+		status = "amended"
+	}
+
+	observations, err := buildObservations(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []BundleEntry
+	results := []Reference{}
+	for _, o := range observations {
+		o.obs.Status = status
+		o.obs.Subject = subject
+		o.obs.EffectiveDateTime = effective
+		o.obs.Issued = issued
+		url := resourceURL("Observation", r.ReportID, o.key)
+		entry, err := newEntry(url, o.obs)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+		results = append(results, Reference{Reference: url, Display: o.obs.Code.Text})
+	}
+
+	report := DiagnosticReport{
+		ResourceType: "DiagnosticReport",
+		Meta:         &Meta{VersionID: strconv.Itoa(int(r.Version)), LastUpdated: issued},
+		Identifier:   []Identifier{{System: SystemReport, Value: r.ReportID}},
+		Status:       status,
+		Category: []CodeableConcept{{
+			Coding: []Coding{{System: SystemDiagnosticServ, Code: "LAB", Display: "Laboratory"}},
+		}},
+		Code: CodeableConcept{
+			Coding: []Coding{{System: SystemLOINC, Code: "11502-2", Display: "Laboratory report"}},
+			Text:   "健康檢查報告",
+		},
+		Subject:           subject,
+		EffectiveDateTime: effective,
+		Issued:            issued,
+		Performer: []Reference{{
+			Identifier: &Identifier{System: SystemClinic, Value: r.ClinicID},
+			Display:    r.ClinicName,
+		}},
+		Result: results,
+	}
+	reportEntry, err := newEntry(resourceURL("DiagnosticReport", r.ReportID), report)
+	if err != nil {
+		return nil, err
+	}
+	patientEntry, err := newEntry(patientURL, Patient{
+		ResourceType: "Patient",
+		Identifier:   []Identifier{{System: SystemPseudonym, Value: r.PatientHash}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := Bundle{
+		ResourceType: "Bundle",
+		ID:           nameUUID("Bundle", r.ReportID, strconv.Itoa(int(r.Version))),
+		Type:         "collection",
+		Timestamp:    now.UTC().Format(time.RFC3339),
+		Entry:        append([]BundleEntry{reportEntry, patientEntry}, entries...),
+	}
+	return json.Marshal(bundle)
+}
+
+type keyedObservation struct {
+	key string
+	obs Observation
+}
+
+// buildObservations 依報告格式產生 Observation，結構化檢驗結果（schemaVersion 2）帶 LOINC 與 UCUM 編碼，
+// 舊的自由格式字串盡量拆出數值與單位，拆不出時以 valueString 保留原文
+func buildObservations(r Report) ([]keyedObservation, error) {
+	var out []keyedObservation
+	category := []CodeableConcept{{
+		Coding: []Coding{{System: SystemObsCategory, Code: "laboratory", Display: "Laboratory"}},
+	}}
+	localSystem := systemLocalCodes + r.ClinicID
+
+	if r.SchemaVersion >= 2 {
+		var all map[string]analyte.Result
+		if err := json.Unmarshal([]byte(r.ResultJSON), &all); err != nil {
+			return nil, fmt.Errorf("無法解析檢驗結果: %v", err)
+		}
+		for _, key := range sortedKeys(all) {
+			res := all[key]
+			obs := Observation{ResourceType: "Observation", Category: category}
+
+			localCode := res.LocalCode
+			if localCode == "" && res.Loinc == "" {
+				localCode = key
+			}
+			if res.Loinc != "" {
+				obs.Code.Coding = append(obs.Code.Coding, Coding{System: SystemLOINC, Code: res.Loinc, Display: res.NameEN})
+			}
+			if localCode != "" {
+				obs.Code.Coding = append(obs.Code.Coding, Coding{System: localSystem, Code: localCode})
+			}
+			obs.Code.Text = firstNonEmpty(res.NameZH, localCode, key)
+
+			// 正規化後的單位為 UCUM 寫法，對應不到字典的項目只保留原單位
+			ucum := res.Loinc != ""
+			if res.Value != nil {
+				obs.ValueQuantity = quantity(*res.Value, res.Unit, ucum)
+			} else if res.Text != "" {
+				obs.ValueString = res.Text
+			}
+			if rr := res.ReferenceRange; rr != nil {
+				frange := ReferenceRange{Text: rr.Text}
+				if rr.Low != nil {
+					frange.Low = quantity(*rr.Low, res.Unit, ucum)
+				}
+				if rr.High != nil {
+					frange.High = quantity(*rr.High, res.Unit, ucum)
+				}
+				obs.ReferenceRange = []ReferenceRange{frange}
+			}
+			if res.Flag != "" {
+				obs.Interpretation = []CodeableConcept{{
+					Coding: []Coding{{System: SystemInterpretation, Code: res.Flag, Display: interpretationDisplay[res.Flag]}},
+				}}
+			}
+			if res.Method != "" {
+				obs.Method = &CodeableConcept{Text: res.Method}
+			}
+			if res.Specimen != "" {
+				obs.Specimen = &Reference{Display: res.Specimen}
+			}
+			out = append(out, keyedObservation{key: key, obs: obs})
+		}
+		return out, nil
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(r.ResultJSON), &all); err != nil {
+		return nil, fmt.Errorf("無法解析檢驗結果: %v", err)
+	}
+	for _, key := range sortedKeys(all) {
+		obs := Observation{
+			ResourceType: "Observation",
+			Category:     category,
+			Code: CodeableConcept{
+				Coding: []Coding{{System: localSystem, Code: key}},
+				Text:   key,
+			},
+		}
+		if loinc := defaultLOINC(key); loinc != nil {
+			obs.Code.Coding = append([]Coding{{System: SystemLOINC, Code: loinc.LOINC, Display: loinc.NameEN}}, obs.Code.Coding...)
+		}
+
+		var num float64
+		var str string
+		switch {
+		case json.Unmarshal(all[key], &num) == nil:
+			obs.ValueQuantity = &Quantity{Value: num}
+		case json.Unmarshal(all[key], &str) == nil:
+			if q := parseLegacyValue(str); q != nil {
+				obs.ValueQuantity = q
+			} else {
+				obs.ValueString = str
+			}
+		default:
+			obs.ValueString = string(all[key])
+		}
+		out = append(out, keyedObservation{key: key, obs: obs})
+	}
+	return out, nil
+}
+
+// defaultLOINC 舊報告以內建對應補上 LOINC 代碼，單位不換算
+func defaultLOINC(code string) *analyte.Analyte {
+	for _, a := range analyte.Dictionary() {
+		for _, c := range a.Codes {
+			if strings.EqualFold(c, strings.TrimSpace(code)) {
+				return &a
+			}
+		}
+	}
+	return nil
+}
+
+// parseLegacyValue 拆解 "89 mg/dL" 這類字串，"127/61 mmHg" 等無法以單一數值表示時回傳 nil
+func parseLegacyValue(s string) *Quantity {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil
+	}
+	if len(fields) == 1 {
+		return &Quantity{Value: v}
+	}
+	return quantity(v, fields[1], false)
+}
+
+func quantity(v float64, unit string, ucum bool) *Quantity {
+	q := &Quantity{Value: v, Unit: unit}
+	if ucum && unit != "" {
+		q.System = SystemUCUM
+		q.Code = unit
+	}
+	return q
+}
+
+func newEntry(url string, resource interface{}) (BundleEntry, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return BundleEntry{}, fmt.Errorf("無法產生 FHIR 資源: %v", err)
+	}
+	return BundleEntry{FullURL: url, Resource: b}, nil
+}
+
+// nameUUID 以名稱產生固定的 UUID（v5 格式），同一份報告重複匯出時資源識別不變
+func nameUUID(parts ...string) string {
+	sum := sha1.Sum([]byte(strings.Join(parts, "\x00")))
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+func resourceURL(parts ...string) string {
+	return "urn:uuid:" + nameUUID(parts...)
+}
+
+func instant(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,212 @@
+package fhir
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// decodeBundle 拆出 Bundle 中的 DiagnosticReport 與 Observation（以 code.text 為鍵）
+func decodeBundle(t *testing.T, b []byte) (Bundle, DiagnosticReport, map[string]Observation) {
+	t.Helper()
+	var bundle Bundle
+	if err := json.Unmarshal(b, &bundle); err != nil {
+		t.Fatal(err)
+	}
+	var report DiagnosticReport
+	observations := map[string]Observation{}
+	for _, e := range bundle.Entry {
+		var head struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(e.Resource, &head); err != nil {
+			t.Fatal(err)
+		}
+		switch head.ResourceType {
+		case "DiagnosticReport":
+			if err := json.Unmarshal(e.Resource, &report); err != nil {
+				t.Fatal(err)
+			}
+		case "Observation":
+			var o Observation
+			if err := json.Unmarshal(e.Resource, &o); err != nil {
+				t.Fatal(err)
+			}
+			observations[o.Code.Text] = o
+		}
+	}
+	return bundle, report, observations
+}
+
+func TestBuildBundleReport(t *testing.T) {
+	r := Report{
+		ReportID: "R001", ClinicID: "C1", ClinicName: "Clinic", PatientHash: "ph",
+		Version: 2, SchemaVersion: 2, CreatedAt: 1700000000, AmendedAt: 1700003600,
+		ResultJSON: `{"1558-6": {"value": 89, "unit": "mg/dL", "flag": "N", "loinc": "1558-6"}}`,
+	}
+	now := time.Unix(1700007200, 0)
+	b, err := BuildBundle(r, now)
+	if err != nil {
+		t.Fatalf("BuildBundle: %v", err)
+	}
+	bundle, report, observations := decodeBundle(t, b)
+	if bundle.Type != "collection" || len(bundle.Entry) != 3 || bundle.Timestamp != "2023-11-15T00:13:20Z" {
+		t.Fatalf("bundle = %+v", bundle)
+	}
+	if report.Status != "amended" || report.Meta.VersionID != "2" || report.Issued != "2023-11-14T23:13:20Z" || report.EffectiveDateTime != "2023-11-14T22:13:20Z" {
+		t.Errorf("report = %+v", report)
+	}
+	if len(report.Identifier) != 1 || report.Identifier[0] != (Identifier{System: SystemReport, Value: "R001"}) {
+		t.Errorf("identifier = %+v", report.Identifier)
+	}
+	if len(report.Result) != 1 || len(observations) != 1 {
+		t.Fatalf("result = %+v, observations = %+v", report.Result, observations)
+	}
+	for _, o := range observations {
+		if o.Status != "amended" || o.Subject != report.Subject {
+			t.Errorf("observation = %+v", o)
+		}
+	}
+
+	// 同一版本重複匯出時資源識別不變
+	again, err := BuildBundle(r, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b2, _, _ := decodeBundle(t, again); b2.ID != bundle.ID || b2.Entry[0].FullURL != bundle.Entry[0].FullURL {
+		t.Errorf("bundle identity changed: %s vs %s", b2.ID, bundle.ID)
+	}
+}
+
+func TestBuildBundleObservations(t *testing.T) {
+	num := func(v float64) *Quantity { return &Quantity{Value: v} }
+	ucum := func(v float64, unit string) *Quantity {
+		return &Quantity{Value: v, Unit: unit, System: SystemUCUM, Code: unit}
+	}
+	tests := []struct {
+		name           string
+		schemaVersion  int32
+		resultJSON     string
+		text           string
+		coding         []Coding
+		quantity       *Quantity
+		valueString    string
+		interpretation string
+		rangeLow       *Quantity
+	}{
+		{
+			name:           "structured result with LOINC",
+			schemaVersion:  2,
+			resultJSON:     `{"1558-6": {"value": 89, "unit": "mg/dL", "referenceRange": {"low": 70, "high": 99}, "flag": "N", "loinc": "1558-6", "localCode": "Glu-AC", "nameZh": "空腹血糖", "nameEn": "Glucose"}}`,
+			text:           "空腹血糖",
+			coding:         []Coding{{System: SystemLOINC, Code: "1558-6", Display: "Glucose"}, {System: "urn:medledger:clinic-code:C1", Code: "Glu-AC"}},
+			quantity:       ucum(89, "mg/dL"),
+			interpretation: "N",
+			rangeLow:       ucum(70, "mg/dL"),
+		},
+		{
+			name:           "structured result without LOINC keeps the clinic unit",
+			schemaVersion:  2,
+			resultJSON:     `{"XYZ": {"value": 1, "unit": "U", "referenceRange": {"low": 0}, "flag": "H"}}`,
+			text:           "XYZ",
+			coding:         []Coding{{System: "urn:medledger:clinic-code:C1", Code: "XYZ"}},
+			quantity:       &Quantity{Value: 1, Unit: "U"},
+			interpretation: "H",
+			rangeLow:       &Quantity{Value: 0, Unit: "U"},
+		},
+		{
+			name:           "qualitative result",
+			schemaVersion:  2,
+			resultJSON:     `{"5804-0": {"text": "+", "referenceRange": {"text": "-"}, "flag": "A", "loinc": "5804-0", "nameZh": "尿蛋白"}}`,
+			text:           "尿蛋白",
+			coding:         []Coding{{System: SystemLOINC, Code: "5804-0"}},
+			valueString:    "+",
+			interpretation: "A",
+		},
+		{
+			name:          "legacy string with unit",
+			schemaVersion: 1,
+			resultJSON:    `{"Glu-AC": "89 mg/dL"}`,
+			text:          "Glu-AC",
+			coding:        []Coding{{System: SystemLOINC, Code: "1558-6", Display: defaultLOINC("Glu-AC").NameEN}, {System: "urn:medledger:clinic-code:C1", Code: "Glu-AC"}},
+			quantity:      &Quantity{Value: 89, Unit: "mg/dL"},
+		},
+		{
+			name:          "legacy number",
+			schemaVersion: 1,
+			resultJSON:    `{"Note": 3.5}`,
+			text:          "Note",
+			coding:        []Coding{{System: "urn:medledger:clinic-code:C1", Code: "Note"}},
+			quantity:      num(3.5),
+		},
+		{
+			name:          "legacy blood pressure stays text",
+			schemaVersion: 1,
+			resultJSON:    `{"BP": "127/61 mmHg"}`,
+			text:          "BP",
+			coding:        []Coding{{System: "urn:medledger:clinic-code:C1", Code: "BP"}},
+			valueString:   "127/61 mmHg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := BuildBundle(Report{ReportID: "R001", ClinicID: "C1", PatientHash: "ph", Version: 1, SchemaVersion: tt.schemaVersion, ResultJSON: tt.resultJSON}, time.Unix(0, 0))
+			if err != nil {
+				t.Fatalf("BuildBundle: %v", err)
+			}
+			_, _, observations := decodeBundle(t, b)
+			o, ok := observations[tt.text]
+			if !ok || len(observations) != 1 {
+				t.Fatalf("observations = %+v, want %q", observations, tt.text)
+			}
+			if !reflect.DeepEqual(o.Code.Coding, tt.coding) {
+				t.Errorf("coding = %+v, want %+v", o.Code.Coding, tt.coding)
+			}
+			if !reflect.DeepEqual(o.ValueQuantity, tt.quantity) || o.ValueString != tt.valueString {
+				t.Errorf("value = %+v / %q, want %+v / %q", o.ValueQuantity, o.ValueString, tt.quantity, tt.valueString)
+			}
+			var interpretation string
+			if len(o.Interpretation) > 0 {
+				interpretation = o.Interpretation[0].Coding[0].Code
+			}
+			if interpretation != tt.interpretation {
+				t.Errorf("interpretation = %q, want %q", interpretation, tt.interpretation)
+			}
+			var low *Quantity
+			if len(o.ReferenceRange) > 0 {
+				low = o.ReferenceRange[0].Low
+			}
+			if !reflect.DeepEqual(low, tt.rangeLow) {
+				t.Errorf("referenceRange.low = %+v, want %+v", low, tt.rangeLow)
+			}
+		})
+	}
+}
+
+func TestBuildBundleInvalidResult(t *testing.T) {
+	for _, v := range []int32{1, 2} {
+		if _, err := BuildBundle(Report{ReportID: "R001", SchemaVersion: v, ResultJSON: "not json"}, time.Now()); err == nil {
+			t.Errorf("schemaVersion %d: expected error", v)
+		}
+	}
+}
+
+func TestParseLegacyValue(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *Quantity
+	}{
+		{"89 mg/dL", &Quantity{Value: 89, Unit: "mg/dL"}},
+		{"1.2", &Quantity{Value: 1.2}},
+		{"127/61 mmHg", nil},
+		{"negative", nil},
+		{"5.2 x 10^6", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := parseLegacyValue(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseLegacyValue(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
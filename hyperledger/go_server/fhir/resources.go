@@ -0,0 +1,111 @@
+// Package fhir 將健檢報告轉為 FHIR R4 資源，只包含匯出與匯入報告所需的欄位
+package fhir
+
+import "encoding/json"
+
+const (
+	SystemLOINC          = "http://loinc.org"
+	SystemUCUM           = "http://unitsofmeasure.org"
+	SystemInterpretation = "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation"
+	SystemDiagnosticServ = "http://terminology.hl7.org/CodeSystem/v2-0074"
+	SystemObsCategory    = "http://terminology.hl7.org/CodeSystem/observation-category"
+
+	// 平台自訂的識別碼系統
+	SystemPseudonym  = "urn:medledger:pseudonym"
+	SystemReport     = "urn:medledger:report"
+	SystemClinic     = "urn:medledger:clinic"
+	systemLocalCodes = "urn:medledger:clinic-code:" // 後接 clinicId
+)
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	ID           string        `json:"id,omitempty"`
+	Meta         *Meta         `json:"meta,omitempty"`
+	Identifier   *Identifier   `json:"identifier,omitempty"`
+	Type         string        `json:"type"`
+	Timestamp    string        `json:"timestamp,omitempty"`
+	Entry        []BundleEntry `json:"entry"`
+}
+
+type BundleEntry struct {
+	FullURL  string          `json:"fullUrl"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+type Meta struct {
+	VersionID   string `json:"versionId,omitempty"`
+	LastUpdated string `json:"lastUpdated,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Identifier struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value"`
+}
+
+type Reference struct {
+	Reference  string      `json:"reference,omitempty"`
+	Identifier *Identifier `json:"identifier,omitempty"`
+	Display    string      `json:"display,omitempty"`
+}
+
+type Quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+type ReferenceRange struct {
+	Low  *Quantity `json:"low,omitempty"`
+	High *Quantity `json:"high,omitempty"`
+	Text string    `json:"text,omitempty"`
+}
+
+type Patient struct {
+	ResourceType string       `json:"resourceType"`
+	ID           string       `json:"id,omitempty"`
+	Identifier   []Identifier `json:"identifier"`
+}
+
+type Observation struct {
+	ResourceType      string            `json:"resourceType"`
+	ID                string            `json:"id,omitempty"`
+	Status            string            `json:"status"`
+	Category          []CodeableConcept `json:"category,omitempty"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           Reference         `json:"subject"`
+	EffectiveDateTime string            `json:"effectiveDateTime,omitempty"`
+	Issued            string            `json:"issued,omitempty"`
+	ValueQuantity     *Quantity         `json:"valueQuantity,omitempty"`
+	ValueString       string            `json:"valueString,omitempty"`
+	Interpretation    []CodeableConcept `json:"interpretation,omitempty"`
+	Method            *CodeableConcept  `json:"method,omitempty"`
+	Specimen          *Reference        `json:"specimen,omitempty"`
+	ReferenceRange    []ReferenceRange  `json:"referenceRange,omitempty"`
+}
+
+type DiagnosticReport struct {
+	ResourceType      string            `json:"resourceType"`
+	ID                string            `json:"id,omitempty"`
+	Meta              *Meta             `json:"meta,omitempty"`
+	Identifier        []Identifier      `json:"identifier"`
+	Status            string            `json:"status"`
+	Category          []CodeableConcept `json:"category"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           Reference         `json:"subject"`
+	EffectiveDateTime string            `json:"effectiveDateTime,omitempty"`
+	Issued            string            `json:"issued,omitempty"`
+	Performer         []Reference       `json:"performer,omitempty"`
+	Result            []Reference       `json:"result"`
+}
@@ -0,0 +1,268 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go_server/analyte"
+	"go_server/fhir"
+)
+
+type fhirResource struct {
+	ResourceType string `json:"resourceType"`
+	ID           string `json:"id"`
+}
+
+type fhirIndex struct {
+	patients     map[string]fhir.Patient
+	observations map[string]fhir.Observation
+}
+
+// ParseFHIR 解析 FHIR R4 Bundle：每個 DiagnosticReport 為一份報告，包含其 result 參照的 Observation；
+// 沒有 DiagnosticReport 時所有 Observation 合為一份報告，報告ID 取 Bundle.identifier 或 Bundle.id。
+// 病患取自 subject 參照的 Patient.identifier，或 subject.identifier 本身
+func ParseFHIR(payload []byte, identifierSystem string) (*Batch, error) {
+	var bundle fhir.Bundle
+	if err := json.Unmarshal(payload, &bundle); err != nil {
+		return nil, fmt.Errorf("無法解析 FHIR Bundle: %v", err)
+	}
+	if bundle.ResourceType != "Bundle" {
+		return nil, fmt.Errorf("resourceType 必須是 Bundle")
+	}
+	batch := &Batch{Format: FormatFHIR, MessageID: bundle.ID}
+	if bundle.Identifier != nil && bundle.Identifier.Value != "" {
+		batch.MessageID = bundle.Identifier.Value
+	}
+
+	idx := fhirIndex{patients: map[string]fhir.Patient{}, observations: map[string]fhir.Observation{}}
+	var (
+		reports  []fhir.DiagnosticReport
+		obsOrder []string
+	)
+	for i, e := range bundle.Entry {
+		var head fhirResource
+		if err := json.Unmarshal(e.Resource, &head); err != nil {
+			return nil, fmt.Errorf("entry[%d] 無法解析: %v", i, err)
+		}
+		keys := []string{e.FullURL}
+		if head.ID != "" {
+			keys = append(keys, head.ResourceType+"/"+head.ID)
+		}
+		switch head.ResourceType {
+		case "Patient":
+			var p fhir.Patient
+			if err := json.Unmarshal(e.Resource, &p); err != nil {
+				return nil, fmt.Errorf("entry[%d] Patient 無法解析: %v", i, err)
+			}
+			for _, k := range keys {
+				idx.patients[k] = p
+			}
+		case "Observation":
+			var o fhir.Observation
+			if err := json.Unmarshal(e.Resource, &o); err != nil {
+				return nil, fmt.Errorf("entry[%d] Observation 無法解析: %v", i, err)
+			}
+			ref := observationRef(o, i)
+			for _, k := range keys {
+				idx.observations[k] = o
+			}
+			idx.observations[ref] = o
+			obsOrder = append(obsOrder, ref)
+		case "DiagnosticReport":
+			var d fhir.DiagnosticReport
+			if err := json.Unmarshal(e.Resource, &d); err != nil {
+				return nil, fmt.Errorf("entry[%d] DiagnosticReport 無法解析: %v", i, err)
+			}
+			reports = append(reports, d)
+		}
+	}
+
+	if len(reports) == 0 {
+		if len(obsOrder) == 0 {
+			return nil, fmt.Errorf("Bundle 中沒有 DiagnosticReport 或 Observation")
+		}
+		if batch.MessageID == "" {
+			return nil, fmt.Errorf("沒有 DiagnosticReport 時 Bundle 必須有 identifier 或 id 作為報告ID")
+		}
+		r := newReport(batch.MessageID, "Bundle")
+		subject := idx.observations[obsOrder[0]].Subject
+		for _, ref := range obsOrder {
+			o := idx.observations[ref]
+			if !sameSubject(o.Subject, subject) {
+				r.addIssue(ref, "subject", "同一份報告的 Observation 必須屬於同一位病患")
+				continue
+			}
+			parseObservation(&r, ref, o)
+		}
+		r.PatientID = idx.patientID(&r, subject, identifierSystem)
+		batch.Reports = append(batch.Reports, r)
+		return batch, nil
+	}
+
+	for i, d := range reports {
+		sourceRef := fmt.Sprintf("DiagnosticReport[%d]", i+1)
+		if d.ID != "" {
+			sourceRef = "DiagnosticReport/" + d.ID
+		}
+		reportID := d.ID
+		if len(d.Identifier) > 0 && d.Identifier[0].Value != "" {
+			reportID = d.Identifier[0].Value
+		}
+		r := newReport(reportID, sourceRef)
+		if reportID == "" {
+			r.addIssue(sourceRef, "identifier", "DiagnosticReport 必須有 identifier 或 id 作為報告ID")
+		}
+		if !finalStatus(d.Status) {
+			r.addIssue(sourceRef, "status", fmt.Sprintf("報告狀態 %q 不是最終結果", d.Status))
+		}
+		if len(d.Result) == 0 {
+			r.addIssue(sourceRef, "result", "報告沒有任何檢驗結果")
+		}
+		for _, res := range d.Result {
+			o, ok := idx.observations[res.Reference]
+			if !ok {
+				r.addIssue(sourceRef, "result", fmt.Sprintf("找不到 Observation %q", res.Reference))
+				continue
+			}
+			parseObservation(&r, res.Reference, o)
+		}
+		r.PatientID = idx.patientID(&r, d.Subject, identifierSystem)
+		batch.Reports = append(batch.Reports, r)
+	}
+	return batch, nil
+}
+
+// parseObservation 將 Observation 轉為檢驗結果，只接受 valueQuantity；LOINC 編碼填入 loinc，
+// 其他編碼系統的代碼作為健檢中心自訂代碼
+func parseObservation(r *Report, ref string, o fhir.Observation) {
+	var code, loinc string
+	for _, c := range o.Code.Coding {
+		switch {
+		case c.System == fhir.SystemLOINC && loinc == "":
+			loinc = c.Code
+		case c.System != fhir.SystemLOINC && code == "":
+			code = c.Code
+		}
+	}
+	if code == "" {
+		code = loinc
+	}
+	if code == "" {
+		r.addIssue(ref, "code", "缺少檢驗項目代碼")
+		return
+	}
+	if !finalStatus(o.Status) {
+		r.addIssue(ref, code, fmt.Sprintf("結果狀態 %q 不是最終結果", o.Status))
+		return
+	}
+	if o.ValueQuantity == nil {
+		r.addIssue(ref, code, "只接受 valueQuantity 數值結果")
+		return
+	}
+
+	res := analyte.Result{
+		Value: float(o.ValueQuantity.Value),
+		Unit:  o.ValueQuantity.Unit,
+		Loinc: loinc,
+	}
+	if o.ValueQuantity.System == fhir.SystemUCUM && o.ValueQuantity.Code != "" {
+		res.Unit = o.ValueQuantity.Code
+	}
+	if !analyte.ValidLOINC(loinc) {
+		res.Loinc = ""
+	}
+	if len(o.ReferenceRange) > 0 {
+		rr := o.ReferenceRange[0]
+		res.ReferenceRange = &analyte.Range{Text: rr.Text}
+		if rr.Low != nil {
+			res.ReferenceRange.Low = float(rr.Low.Value)
+		}
+		if rr.High != nil {
+			res.ReferenceRange.High = float(rr.High.Value)
+		}
+	}
+	if o.Method != nil {
+		res.Method = firstNonEmpty(o.Method.Text, firstCode(*o.Method))
+	}
+	if o.Specimen != nil {
+		res.Specimen = o.Specimen.Display
+	}
+
+	var flag string
+	if len(o.Interpretation) > 0 {
+		flag = firstCode(o.Interpretation[0])
+	}
+	flag, err := normalizeFlag(flag, res.Value, res.ReferenceRange)
+	if err != nil {
+		r.addIssue(ref, code, err.Error())
+		return
+	}
+	res.Flag = flag
+	r.addResult(code, ref, res)
+}
+
+// patientID 依 subject 找出病患識別碼，identifierSystem 比對 Patient.identifier.system
+func (idx fhirIndex) patientID(r *Report, subject fhir.Reference, identifierSystem string) string {
+	var identifiers []fhir.Identifier
+	if p, ok := idx.patients[subject.Reference]; ok {
+		identifiers = p.Identifier
+	} else if subject.Identifier != nil {
+		identifiers = []fhir.Identifier{*subject.Identifier}
+	} else {
+		r.addIssue(r.SourceRef, "subject", fmt.Sprintf("找不到病患 %q", subject.Reference))
+		return ""
+	}
+	for _, id := range identifiers {
+		if id.Value != "" && (identifierSystem == "" || id.System == identifierSystem) {
+			return id.Value
+		}
+	}
+	if identifierSystem != "" {
+		r.addIssue(r.SourceRef, "subject", fmt.Sprintf("找不到 system 為 %s 的病患識別碼", identifierSystem))
+	} else {
+		r.addIssue(r.SourceRef, "subject", "缺少病患識別碼")
+	}
+	return ""
+}
+
+func observationRef(o fhir.Observation, i int) string {
+	if o.ID != "" {
+		return "Observation/" + o.ID
+	}
+	return fmt.Sprintf("entry[%d]", i)
+}
+
+func sameSubject(a, b fhir.Reference) bool {
+	if a.Reference != "" || b.Reference != "" {
+		return a.Reference == b.Reference
+	}
+	return a.Identifier != nil && b.Identifier != nil && *a.Identifier == *b.Identifier
+}
+
+func finalStatus(status string) bool {
+	switch status {
+	case "final", "amended", "corrected":
+		return true
+	default:
+		return false
+	}
+}
+
+func firstCode(c fhir.CodeableConcept) string {
+	for _, coding := range c.Coding {
+		if coding.Code != "" {
+			return strings.TrimSpace(coding.Code)
+		}
+	}
+	return c.Text
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package ingest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	testPatient    = `{"resourceType": "Patient", "id": "p1", "identifier": [{"system": "urn:oid:hosp", "value": "P123"}, {"system": "urn:oid:nhi", "value": "A987"}]}`
+	testGlucose    = `{"resourceType": "Observation", "id": "o1", "status": "final", "code": {"coding": [{"system": "http://loinc.org", "code": "1558-6"}, {"system": "urn:lis", "code": "GLU"}]}, "subject": {"reference": "Patient/p1"}, "valueQuantity": {"value": 89, "unit": "mg/dL", "system": "http://unitsofmeasure.org", "code": "mg/dL"}, "referenceRange": [{"low": {"value": 70}, "high": {"value": 99}}]}`
+	testFHIRReport = `{"resourceType": "DiagnosticReport", "id": "d1", "identifier": [{"value": "R001"}], "status": "final", "subject": {"reference": "Patient/p1"}, "result": [{"reference": "Observation/o1"}]}`
+)
+
+// bundle 以各資源的 JSON 組成 Bundle，header 為附加在 Bundle 上的欄位
+func bundle(header string, resources ...string) []byte {
+	var entries []string
+	for i, r := range resources {
+		entries = append(entries, fmt.Sprintf(`{"fullUrl": "urn:uuid:%d", "resource": %s}`, i, r))
+	}
+	return []byte(fmt.Sprintf(`{"resourceType": "Bundle", "type": "collection"%s, "entry": [%s]}`, header, strings.Join(entries, ",")))
+}
+
+func TestParseFHIR(t *testing.T) {
+	batch, err := ParseFHIR(bundle(`, "id": "B1"`, testPatient, testGlucose, testFHIRReport), "urn:oid:nhi")
+	if err != nil {
+		t.Fatalf("ParseFHIR: %v", err)
+	}
+	if batch.MessageID != "B1" || len(batch.Reports) != 1 {
+		t.Fatalf("batch = %+v", batch)
+	}
+	r := batch.Reports[0]
+	if r.ReportID != "R001" || r.SourceRef != "DiagnosticReport/d1" || r.PatientID != "A987" || len(r.Errors) != 0 {
+		t.Fatalf("report = %+v", r)
+	}
+	res, ok := r.Results["GLU"]
+	if !ok || res.Value == nil || *res.Value != 89 || res.Unit != "mg/dL" || res.Loinc != "1558-6" || res.Flag != "N" {
+		t.Fatalf("GLU = %+v", res)
+	}
+	if r.SourceRefs["GLU"] != "Observation/o1" {
+		t.Errorf("sourceRef = %q", r.SourceRefs["GLU"])
+	}
+}
+
+func TestParseFHIRWithoutDiagnosticReport(t *testing.T) {
+	batch, err := ParseFHIR(bundle(`, "identifier": {"value": "R002"}`, testPatient, testGlucose), "")
+	if err != nil {
+		t.Fatalf("ParseFHIR: %v", err)
+	}
+	r := batch.Reports[0]
+	if r.ReportID != "R002" || r.SourceRef != "Bundle" || r.PatientID != "P123" || len(r.Errors) != 0 || len(r.Results) != 1 {
+		t.Fatalf("report = %+v", r)
+	}
+}
+
+func TestParseFHIRMalformedBundle(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload []byte
+		wantErr string
+	}{
+		{"not JSON", []byte("MSH|^~\\&|"), "無法解析 FHIR Bundle"},
+		{"not a Bundle", []byte(testGlucose), "resourceType 必須是 Bundle"},
+		{"entry is not an object", bundle("", `"Observation"`), "entry[0] 無法解析"},
+		{"malformed Observation", bundle("", `{"resourceType": "Observation", "valueQuantity": {"value": "89"}}`), "entry[0] Observation 無法解析"},
+		{"no results", bundle(`, "id": "B1"`, testPatient), "沒有 DiagnosticReport 或 Observation"},
+		{"no report id", bundle("", testPatient, testGlucose), "必須有 identifier 或 id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFHIR(tt.payload, "")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseFHIRReportIssues(t *testing.T) {
+	replace := func(s, old, new string) string { return strings.Replace(s, old, new, 1) }
+	tests := []struct {
+		name             string
+		resources        []string
+		identifierSystem string
+		wantRef          string
+		wantField        string
+	}{
+		{"preliminary report", []string{testPatient, testGlucose, replace(testFHIRReport, `"final"`, `"preliminary"`)}, "", "DiagnosticReport/d1", "status"},
+		{"missing report id", []string{testPatient, testGlucose, replace(testFHIRReport, `"id": "d1", "identifier": [{"value": "R001"}], `, "")}, "", "DiagnosticReport[1]", "identifier"},
+		{"empty result", []string{testPatient, testGlucose, replace(testFHIRReport, `{"reference": "Observation/o1"}`, "")}, "", "DiagnosticReport/d1", "result"},
+		{"dangling result", []string{testPatient, testGlucose, replace(testFHIRReport, "Observation/o1", "Observation/o2")}, "", "DiagnosticReport/d1", "result"},
+		{"missing code", []string{testPatient, replace(testGlucose, `{"system": "http://loinc.org", "code": "1558-6"}, {"system": "urn:lis", "code": "GLU"}`, ""), testFHIRReport}, "", "Observation/o1", "code"},
+		{"preliminary observation", []string{testPatient, replace(testGlucose, `"final"`, `"preliminary"`), testFHIRReport}, "", "Observation/o1", "GLU"},
+		{"valueString", []string{testPatient, replace(testGlucose, `"valueQuantity": {"value": 89, "unit": "mg/dL", "system": "http://unitsofmeasure.org", "code": "mg/dL"}`, `"valueString": "negative"`), testFHIRReport}, "", "Observation/o1", "GLU"},
+		{"unknown interpretation", []string{testPatient, replace(testGlucose, `"referenceRange"`, `"interpretation": [{"coding": [{"code": "X"}]}], "referenceRange"`), testFHIRReport}, "", "Observation/o1", "GLU"},
+		{"patient not in bundle", []string{testGlucose, testFHIRReport}, "", "DiagnosticReport/d1", "subject"},
+		{"identifier system not found", []string{testPatient, testGlucose, testFHIRReport}, "urn:oid:passport", "DiagnosticReport/d1", "subject"},
+		{"observations for two patients", []string{testPatient, testGlucose, replace(replace(testGlucose, `"o1"`, `"o2"`), "Patient/p1", "Patient/p2")}, "", "Observation/o2", "subject"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch, err := ParseFHIR(bundle(`, "id": "B1"`, tt.resources...), tt.identifierSystem)
+			if err != nil {
+				t.Fatalf("ParseFHIR: %v", err)
+			}
+			errs := batch.Reports[0].Errors
+			if len(errs) != 1 || errs[0].SourceRef != tt.wantRef || errs[0].Field != tt.wantField {
+				t.Fatalf("errors = %+v, want one at %s (%s)", errs, tt.wantRef, tt.wantField)
+			}
+		})
+	}
+}
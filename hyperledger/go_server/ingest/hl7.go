@@ -0,0 +1,410 @@
+package ingest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go_server/analyte"
+)
+
+// encoding 為 MSH-1、MSH-2 宣告的分隔字元
+type encoding struct {
+	field, component, repetition, escape, subcomponent byte
+}
+
+type segment struct {
+	name   string
+	fields []string // fields[i] 為第 i 個欄位，MSH 的 fields[1] 為欄位分隔字元本身
+	index  int      // 同名 segment 的序號，從 1 開始
+}
+
+// field 取得欄位內容，不存在時回傳空字串
+func (s segment) field(i int) string {
+	if i < len(s.fields) {
+		return s.fields[i]
+	}
+	return ""
+}
+
+func (s segment) ref(i int) string {
+	if i == 0 {
+		return fmt.Sprintf("%s[%d]", s.name, s.index)
+	}
+	return fmt.Sprintf("%s[%d]-%d", s.name, s.index, i)
+}
+
+// ParseORU 解析 HL7 v2 ORU^R01 訊息，每個 OBR 群組為一份報告，病患取自其前方最近的 PID-3。
+// 報告ID 依序取 OBR-3（執行單號）、OBR-2（開單單號），都沒有時以 MSH-10 加序號產生
+func ParseORU(message, identifierType string) (*Batch, error) {
+	segments, enc, err := splitSegments(message)
+	if err != nil {
+		return nil, err
+	}
+	msh := segments[0]
+	msgType := components(msh.field(9), enc)
+	if len(msgType) < 2 || msgType[0] != "ORU" || msgType[1] != "R01" {
+		return nil, fmt.Errorf("MSH-9 必須是 ORU^R01，收到 %q", msh.field(9))
+	}
+	batch := &Batch{
+		Format:            FormatHL7,
+		MessageID:         unescape(msh.field(10), enc),
+		sendingApp:        msh.field(3),
+		sendingFacility:   msh.field(4),
+		receivingApp:      msh.field(5),
+		receivingFacility: msh.field(6),
+		version:           msh.field(12),
+	}
+	if batch.MessageID == "" {
+		return nil, fmt.Errorf("MSH-10 訊息控制碼不可為空")
+	}
+
+	var (
+		patientID, patientRef string
+		patientErr            string
+		cur                   *Report
+		specimen              string
+	)
+	flush := func() {
+		if cur != nil {
+			if len(cur.Results) == 0 && len(cur.Errors) == 0 {
+				cur.addIssue(cur.SourceRef, "OBX", "報告沒有任何檢驗結果")
+			}
+			batch.Reports = append(batch.Reports, *cur)
+			cur = nil
+		}
+	}
+
+	for _, seg := range segments[1:] {
+		switch seg.name {
+		case "PID":
+			flush()
+			patientRef = seg.ref(3)
+			patientID, patientErr = pickCX(seg.field(3), identifierType, enc)
+		case "OBR":
+			flush()
+			reportID := firstComponent(seg.field(3), enc)
+			if reportID == "" {
+				reportID = firstComponent(seg.field(2), enc)
+			}
+			if reportID == "" {
+				reportID = fmt.Sprintf("%s-%d", batch.MessageID, seg.index)
+			}
+			r := newReport(reportID, seg.ref(0))
+			switch {
+			case patientRef == "":
+				r.addIssue(seg.ref(0), "PID-3", "OBR 之前沒有 PID segment")
+			case patientErr != "":
+				r.addIssue(patientRef, "PID-3", patientErr)
+			default:
+				r.PatientID = patientID
+			}
+			if status := seg.field(25); status != "" && status != "F" && status != "C" {
+				r.addIssue(seg.ref(25), "OBR-25", fmt.Sprintf("結果狀態 %q 不是最終結果（F 或 C）", status))
+			}
+			specimen = componentText(seg.field(15), enc)
+			cur = &r
+		case "SPM":
+			if cur != nil {
+				if s := componentText(seg.field(4), enc); s != "" {
+					specimen = s
+				}
+			}
+		case "OBX":
+			if cur == nil {
+				return nil, fmt.Errorf("%s 之前沒有 OBR segment", seg.ref(0))
+			}
+			parseOBX(cur, seg, enc, specimen)
+		}
+	}
+	flush()
+	if len(batch.Reports) == 0 {
+		return nil, fmt.Errorf("訊息中沒有 OBR segment")
+	}
+	return batch, nil
+}
+
+// parseOBX 將一個 OBX 轉為檢驗結果：OBX-3 代碼、OBX-5 數值、OBX-6 單位、OBX-7 參考範圍、OBX-8 異常判讀、OBX-11 結果狀態
+func parseOBX(r *Report, seg segment, enc encoding, specimen string) {
+	ref := seg.ref(0)
+	// OBX-3 為 CE/CWE：代碼^名稱^編碼系統^替代代碼^替代名稱^替代編碼系統，LN 表示 LOINC
+	id := components(seg.field(3), enc)
+	for len(id) < 6 {
+		id = append(id, "")
+	}
+	var code, loinc string
+	switch {
+	case id[2] == "LN":
+		code, loinc = id[0], id[0]
+	case id[5] == "LN":
+		code, loinc = id[0], id[3]
+	default:
+		code = id[0]
+	}
+	if code == "" {
+		code = id[3]
+	}
+	if code == "" {
+		r.addIssue(seg.ref(3), "OBX-3", "缺少檢驗項目代碼")
+		return
+	}
+
+	switch status := seg.field(11); status {
+	case "F", "C", "":
+	default:
+		r.addIssue(seg.ref(11), code, fmt.Sprintf("結果狀態 %q 不是最終結果（F 或 C）", status))
+		return
+	}
+
+	res := analyte.Result{
+		Unit:     componentText(seg.field(6), enc),
+		Specimen: specimen,
+		Method:   componentText(seg.field(17), enc),
+		Loinc:    loinc,
+	}
+	if !analyte.ValidLOINC(loinc) {
+		res.Loinc = ""
+	}
+
+	raw := unescape(seg.field(5), enc)
+	switch valueType := seg.field(2); valueType {
+	case "NM":
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			r.addIssue(seg.ref(5), code, fmt.Sprintf("數值 %q 無法解析", raw))
+			return
+		}
+		res.Value = float(v)
+	case "SN":
+		// SN：比較符號^數值^分隔符號^數值，只接受單一數值
+		sn := components(seg.field(5), enc)
+		for len(sn) < 4 {
+			sn = append(sn, "")
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(sn[1]), 64)
+		if (sn[0] != "" && sn[0] != "=") || sn[2] != "" || sn[3] != "" || err != nil {
+			r.addIssue(seg.ref(5), code, fmt.Sprintf("結構化數值 %q 不是單一數值", raw))
+			return
+		}
+		res.Value = float(v)
+	default:
+		r.addIssue(seg.ref(2), code, fmt.Sprintf("不支援的數值類型 %q，只接受 NM 或 SN", valueType))
+		return
+	}
+
+	res.ReferenceRange = parseRange(unescape(seg.field(7), enc))
+	flag, err := normalizeFlag(firstRepetition(seg.field(8), enc), res.Value, res.ReferenceRange)
+	if err != nil {
+		r.addIssue(seg.ref(8), code, err.Error())
+		return
+	}
+	res.Flag = flag
+	r.addResult(code, ref, res)
+}
+
+var (
+	rangeBetween = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s*[-~]\s*(-?\d+(?:\.\d+)?)$`)
+	rangeBound   = regexp.MustCompile(`^(<=|>=|<|>|≤|≥)\s*(-?\d+(?:\.\d+)?)$`)
+)
+
+// parseRange 解析 OBX-7，"70-100" 為上下限，"<5"、">=40" 為單邊界限，其他寫法保留為文字
+func parseRange(s string) *analyte.Range {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if m := rangeBetween.FindStringSubmatch(s); m != nil {
+		low, _ := strconv.ParseFloat(m[1], 64)
+		high, _ := strconv.ParseFloat(m[2], 64)
+		return &analyte.Range{Low: float(low), High: float(high)}
+	}
+	if m := rangeBound.FindStringSubmatch(s); m != nil {
+		v, _ := strconv.ParseFloat(m[2], 64)
+		if strings.HasPrefix(m[1], "<") || m[1] == "≤" {
+			return &analyte.Range{High: float(v)}
+		}
+		return &analyte.Range{Low: float(v)}
+	}
+	return &analyte.Range{Text: s}
+}
+
+// pickCX 從 PID-3 的重複欄位中挑選病患識別碼，identifierType 比對 CX-5（識別碼類型）或 CX-4（指派機構）
+func pickCX(value, identifierType string, enc encoding) (string, string) {
+	for _, rep := range repetitions(value, enc) {
+		cx := components(rep, enc)
+		for len(cx) < 5 {
+			cx = append(cx, "")
+		}
+		if cx[0] == "" {
+			continue
+		}
+		if identifierType == "" || cx[4] == identifierType || firstSub(cx[3], enc) == identifierType {
+			return cx[0], ""
+		}
+	}
+	if identifierType != "" {
+		return "", fmt.Sprintf("找不到類型為 %s 的病患識別碼", identifierType)
+	}
+	return "", "缺少病患識別碼"
+}
+
+// splitSegments 依 MSH 宣告的分隔字元拆解訊息，segment 之間可用 CR、LF 或 CRLF 分隔
+func splitSegments(message string) ([]segment, encoding, error) {
+	var enc encoding
+	message = strings.TrimLeft(message, " \t\r\n\ufeff")
+	if !strings.HasPrefix(message, "MSH") || len(message) < 8 {
+		return nil, enc, fmt.Errorf("訊息必須以 MSH segment 開頭")
+	}
+	enc = encoding{field: message[3], component: '^', repetition: '~', escape: '\\', subcomponent: '&'}
+	chars := message[4:]
+	if i := strings.IndexByte(chars, enc.field); i >= 0 {
+		chars = chars[:i]
+	}
+	for i, p := range []*byte{&enc.component, &enc.repetition, &enc.escape, &enc.subcomponent} {
+		if i < len(chars) {
+			*p = chars[i]
+		}
+	}
+
+	lines := strings.FieldsFunc(message, func(r rune) bool { return r == '\r' || r == '\n' })
+	counts := map[string]int{}
+	var segments []segment
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		parts := strings.Split(line, string(enc.field))
+		name := parts[0]
+		if name == "MSH" {
+			// MSH-1 就是欄位分隔字元，讓欄位序號與規格一致
+			parts = append([]string{name, string(enc.field)}, parts[1:]...)
+		}
+		counts[name]++
+		segments = append(segments, segment{name: name, fields: parts, index: counts[name]})
+	}
+	if counts["MSH"] != 1 {
+		return nil, enc, fmt.Errorf("一則訊息只能有一個 MSH segment")
+	}
+	return segments, enc, nil
+}
+
+func repetitions(value string, enc encoding) []string {
+	return strings.Split(value, string(enc.repetition))
+}
+
+func components(value string, enc encoding) []string {
+	parts := strings.Split(value, string(enc.component))
+	for i := range parts {
+		parts[i] = unescape(parts[i], enc)
+	}
+	return parts
+}
+
+func firstRepetition(value string, enc encoding) string {
+	return unescape(repetitions(value, enc)[0], enc)
+}
+
+func firstComponent(value string, enc encoding) string {
+	return components(repetitions(value, enc)[0], enc)[0]
+}
+
+func firstSub(value string, enc encoding) string {
+	return strings.Split(value, string(enc.subcomponent))[0]
+}
+
+// componentText 取 CE/CWE 的代碼，沒有代碼時取名稱
+func componentText(value string, enc encoding) string {
+	c := components(repetitions(value, enc)[0], enc)
+	if c[0] != "" {
+		return firstSub(c[0], enc)
+	}
+	if len(c) > 1 {
+		return c[1]
+	}
+	return ""
+}
+
+// unescape 還原 \F\、\S\、\T\、\R\、\E\ 跳脫字元，其他跳脫序列（例如格式化指令）直接移除
+func unescape(value string, enc encoding) string {
+	esc := string(enc.escape)
+	if !strings.Contains(value, esc) {
+		return value
+	}
+	var b strings.Builder
+	for {
+		i := strings.Index(value, esc)
+		if i < 0 {
+			b.WriteString(value)
+			break
+		}
+		b.WriteString(value[:i])
+		rest := value[i+1:]
+		j := strings.Index(rest, esc)
+		if j < 0 {
+			b.WriteString(value[i:])
+			break
+		}
+		switch rest[:j] {
+		case "F":
+			b.WriteByte(enc.field)
+		case "S":
+			b.WriteByte(enc.component)
+		case "T":
+			b.WriteByte(enc.subcomponent)
+		case "R":
+			b.WriteByte(enc.repetition)
+		case "E":
+			b.WriteByte(enc.escape)
+		}
+		value = rest[j+1:]
+	}
+	return b.String()
+}
+
+// Outcome 為單一報告的處理結果，用於產生 ACK
+type Outcome struct {
+	ReportID string
+	Accepted bool
+	Message  string
+}
+
+// BuildACK 產生 ACK^R01 回覆：全部接受時 MSA-1 為 AA，部分或全部拒絕時為 AE，每份被拒絕的報告附一個 ERR segment
+func BuildACK(batch *Batch, outcomes []Outcome, controlID string, now time.Time) string {
+	enc := encoding{field: '|', component: '^', repetition: '~', escape: '\\', subcomponent: '&'}
+	escape := func(s string) string {
+		r := strings.NewReplacer(`\`, `\E\`, "|", `\F\`, "^", `\S\`, "&", `\T\`, "~", `\R\`)
+		return r.Replace(s)
+	}
+	version := batch.version
+	if version == "" {
+		version = "2.5.1"
+	}
+
+	code := "AA"
+	var rejected []Outcome
+	for _, o := range outcomes {
+		if !o.Accepted {
+			rejected = append(rejected, o)
+		}
+	}
+	text := fmt.Sprintf("%d report(s) accepted", len(outcomes)-len(rejected))
+	if len(rejected) > 0 {
+		code = "AE"
+		text = fmt.Sprintf("%d of %d report(s) rejected", len(rejected), len(outcomes))
+	}
+
+	segs := []string{
+		strings.Join([]string{"MSH", `^~\&`, batch.receivingApp, batch.receivingFacility,
+			batch.sendingApp, batch.sendingFacility, now.UTC().Format("20060102150405"), "",
+			"ACK^R01^ACK", escape(controlID), "P", version}, string(enc.field)),
+		strings.Join([]string{"MSA", code, escape(batch.MessageID), escape(text)}, string(enc.field)),
+	}
+	for _, o := range rejected {
+		// ERR-3 為 HL7 0357 錯誤碼（207 應用程式錯誤），ERR-4 嚴重度，ERR-8 給使用者的訊息
+		segs = append(segs, strings.Join([]string{"ERR", "", "", "207^Application internal error^HL70357", "E",
+			"", "", "", escape(o.ReportID + ": " + o.Message)}, string(enc.field)))
+	}
+	return strings.Join(segs, "\r") + "\r"
+}
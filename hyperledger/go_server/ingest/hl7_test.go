@@ -0,0 +1,143 @@
+package ingest
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	testMSH = `MSH|^~\&|LIS|CLINIC1|MEDLEDGER|HUB|20240101120000||ORU^R01|MSG001|P|2.5.1`
+	testPID = `PID|1||P123^^^HOSP^MR~A987^^^NHI^NI`
+	testOBR = `OBR|1|ORD1|FILL1`
+)
+
+func oru(segments ...string) string {
+	return strings.Join(segments, "\r")
+}
+
+func TestParseORU(t *testing.T) {
+	batch, err := ParseORU(oru(testMSH, testPID, testOBR,
+		`OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||F`,
+		`OBX|2|NM|CRE^Creatinine^L^2160-0^Creatinine^LN||1.2|mg/dL|0.7-1.3||||F`,
+		`OBX|3|SN|HDL^HDL^L||=^54|mg/dL|>=40||||F`,
+	), "NI")
+	if err != nil {
+		t.Fatalf("ParseORU: %v", err)
+	}
+	if batch.MessageID != "MSG001" || len(batch.Reports) != 1 {
+		t.Fatalf("batch = %+v", batch)
+	}
+	r := batch.Reports[0]
+	if r.ReportID != "FILL1" || r.PatientID != "A987" || len(r.Errors) != 0 {
+		t.Fatalf("report = %+v", r)
+	}
+	tests := []struct {
+		code, loinc, flag string
+		value             float64
+	}{
+		{"1558-6", "1558-6", "N", 89},
+		{"CRE", "2160-0", "N", 1.2}, // 未提供判讀時依參考範圍判斷
+		{"HDL", "", "N", 54},
+	}
+	for _, tt := range tests {
+		res, ok := r.Results[tt.code]
+		if !ok {
+			t.Fatalf("missing result %s", tt.code)
+		}
+		if res.Value == nil || *res.Value != tt.value || res.Loinc != tt.loinc || res.Flag != tt.flag || res.Unit != "mg/dL" {
+			t.Errorf("%s = %+v", tt.code, res)
+		}
+	}
+	if rr := r.Results["HDL"].ReferenceRange; rr == nil || rr.Low == nil || *rr.Low != 40 || rr.High != nil {
+		t.Errorf("HDL range = %+v", rr)
+	}
+}
+
+func TestParseORUMalformedMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		wantErr string
+	}{
+		{"empty", "", "必須以 MSH segment 開頭"},
+		{"not HL7", "hello world", "必須以 MSH segment 開頭"},
+		{"wrong message type", oru(strings.Replace(testMSH, "ORU^R01", "ADT^A01", 1), testPID, testOBR), "MSH-9 必須是 ORU^R01"},
+		{"missing control id", oru(strings.Replace(testMSH, "MSG001", "", 1), testPID, testOBR), "MSH-10 訊息控制碼不可為空"},
+		{"two MSH", oru(testMSH, testMSH, testPID, testOBR), "只能有一個 MSH segment"},
+		{"OBX before OBR", oru(testMSH, testPID, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||F`), "OBX[1] 之前沒有 OBR segment"},
+		{"no OBR", oru(testMSH, testPID), "訊息中沒有 OBR segment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseORU(tt.message, "")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseORUReportIssues(t *testing.T) {
+	tests := []struct {
+		name           string
+		segments       []string
+		identifierType string
+		wantRef        string
+		wantField      string
+	}{
+		{"non-numeric NM", []string{testPID, testOBR, `OBX|1|NM|1558-6^Glucose^LN||high|mg/dL|70-99|H|||F`}, "", "OBX[1]-5", "1558-6"},
+		{"text value type", []string{testPID, testOBR, `OBX|1|ST|1558-6^Glucose^LN||neg||||||F`}, "", "OBX[1]-2", "1558-6"},
+		{"SN range value", []string{testPID, testOBR, `OBX|1|SN|1558-6^Glucose^LN||>^200|mg/dL|70-99|H|||F`}, "", "OBX[1]-5", "1558-6"},
+		{"flag not derivable", []string{testPID, testOBR, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|see note||||F`}, "", "OBX[1]-8", "1558-6"},
+		{"unknown flag", []string{testPID, testOBR, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|X|||F`}, "", "OBX[1]-8", "1558-6"},
+		{"preliminary result", []string{testPID, testOBR, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||P`}, "", "OBX[1]-11", "1558-6"},
+		{"preliminary report", []string{testPID, testOBR + strings.Repeat("|", 22) + "P", `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||F`}, "", "OBR[1]-25", "OBR-25"},
+		{"missing code", []string{testPID, testOBR, `OBX|1|NM|^Glucose||89|mg/dL|70-99|N|||F`}, "", "OBX[1]-3", "OBX-3"},
+		{"duplicate code", []string{testPID, testOBR, `OBX|1|NM|GLU^Glucose||89|mg/dL|70-99|N|||F`, `OBX|2|NM|GLU^Glucose||90|mg/dL|70-99|N|||F`}, "", "OBX[2]", "GLU"},
+		{"no results", []string{testPID, testOBR}, "", "OBR[1]", "OBX"},
+		{"no PID", []string{testOBR, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||F`}, "", "OBR[1]", "PID-3"},
+		{"identifier type not found", []string{testPID, testOBR, `OBX|1|NM|1558-6^Glucose^LN||89|mg/dL|70-99|N|||F`}, "PPN", "PID[1]-3", "PID-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch, err := ParseORU(oru(append([]string{testMSH}, tt.segments...)...), tt.identifierType)
+			if err != nil {
+				t.Fatalf("ParseORU: %v", err)
+			}
+			errs := batch.Reports[0].Errors
+			if len(errs) != 1 || errs[0].SourceRef != tt.wantRef || errs[0].Field != tt.wantField {
+				t.Fatalf("errors = %+v, want one at %s (%s)", errs, tt.wantRef, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		in              string
+		low, high, text string
+	}{
+		{"70-100", "70", "100", ""},
+		{"0.7 ~ 1.3", "0.7", "1.3", ""},
+		{"<5", "", "5", ""},
+		{"≥40", "40", "", ""},
+		{"negative", "", "", "negative"},
+	}
+	for _, tt := range tests {
+		rr := parseRange(tt.in)
+		if rr == nil || fmtFloat(rr.Low) != tt.low || fmtFloat(rr.High) != tt.high || rr.Text != tt.text {
+			t.Errorf("parseRange(%q) = %+v", tt.in, rr)
+		}
+	}
+	if parseRange("  ") != nil {
+		t.Errorf("blank range should be nil")
+	}
+}
+
+func fmtFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
@@ -0,0 +1,129 @@
+// Package ingest 將檢驗系統（LIS）送出的 HL7 v2 ORU^R01 訊息或 FHIR Observation Bundle 解析為報告內容
+// （以檢驗項目代碼為鍵的結構化檢驗結果），之後由 go_server 依檢驗項目字典正規化並上傳
+package ingest
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"go_server/analyte"
+)
+
+const (
+	FormatHL7  = "HL7V2"
+	FormatFHIR = "FHIR"
+)
+
+// Report 為訊息中的一份報告（ORU 的一個 OBR 群組，或 FHIR 的一個 DiagnosticReport）
+type Report struct {
+	ReportID   string
+	SourceRef  string                    // 報告在訊息中的位置，例如 OBR[1] 或 DiagnosticReport/abc
+	PatientID  string                    // 原始病患識別碼（PID-3 / Patient.identifier），由伺服器轉為假名
+	Results    map[string]analyte.Result // 以檢驗項目代碼為鍵
+	SourceRefs map[string]string         // 檢驗項目代碼 → 在訊息中的位置，例如 OBX[3]
+	Errors     []Issue                   // 有任何錯誤時整份報告不上傳
+}
+
+// Issue 為解析時的單一錯誤
+type Issue struct {
+	SourceRef   string
+	Field       string
+	Description string
+}
+
+// Batch 為一則訊息解析後的結果
+type Batch struct {
+	Format    string
+	MessageID string // MSH-10 或 Bundle.id
+	Reports   []Report
+
+	// 回覆 HL7 ACK 時使用的 MSH 欄位
+	sendingApp, sendingFacility, receivingApp, receivingFacility, version string
+}
+
+// Detect 依內容判斷格式
+func Detect(payload string) string {
+	trimmed := strings.TrimSpace(payload)
+	switch {
+	case strings.HasPrefix(trimmed, "MSH"):
+		return FormatHL7
+	case strings.HasPrefix(trimmed, "{"):
+		return FormatFHIR
+	default:
+		return ""
+	}
+}
+
+// Parse 依格式解析訊息；identifierType 用來挑選病患識別碼（HL7 CX-5 識別碼類型或 FHIR identifier.system），空值取第一個
+func Parse(format, payload, identifierType string) (*Batch, error) {
+	if format == "" {
+		format = Detect(payload)
+	}
+	switch strings.ToUpper(format) {
+	case FormatHL7:
+		return ParseORU(payload, identifierType)
+	case FormatFHIR:
+		return ParseFHIR([]byte(payload), identifierType)
+	default:
+		return nil, fmt.Errorf("無法判斷訊息格式，請指定 HL7V2 或 FHIR")
+	}
+}
+
+func newReport(reportID, sourceRef string) Report {
+	return Report{
+		ReportID:   reportID,
+		SourceRef:  sourceRef,
+		Results:    map[string]analyte.Result{},
+		SourceRefs: map[string]string{},
+	}
+}
+
+func (r *Report) addIssue(sourceRef, field, desc string) {
+	r.Errors = append(r.Errors, Issue{SourceRef: sourceRef, Field: field, Description: desc})
+}
+
+// addResult 加入一筆檢驗結果，同一份報告中代碼重複時記為錯誤
+func (r *Report) addResult(code, sourceRef string, res analyte.Result) {
+	if prev, dup := r.SourceRefs[code]; dup {
+		r.addIssue(sourceRef, code, fmt.Sprintf("與 %s 的檢驗項目代碼重複", prev))
+		return
+	}
+	r.Results[code] = res
+	r.SourceRefs[code] = sourceRef
+}
+
+// normalizeFlag 轉換異常判讀，未提供時依參考範圍判斷；無法判斷時回傳錯誤
+func normalizeFlag(flag string, value *float64, rr *analyte.Range) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(flag)) {
+	case "N", "L", "H", "LL", "HH", "A":
+		return strings.ToUpper(strings.TrimSpace(flag)), nil
+	case "AA":
+		return "A", nil
+	case "<":
+		return "L", nil
+	case ">":
+		return "H", nil
+	case "":
+	default:
+		return "", fmt.Errorf("不支援的異常判讀 %q", flag)
+	}
+	if value == nil || rr == nil || (rr.Low == nil && rr.High == nil) {
+		return "", fmt.Errorf("未提供異常判讀，且無數值參考範圍可判斷")
+	}
+	switch {
+	case rr.Low != nil && *value < *rr.Low:
+		return "L", nil
+	case rr.High != nil && *value > *rr.High:
+		return "H", nil
+	default:
+		return "N", nil
+	}
+}
+
+func float(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
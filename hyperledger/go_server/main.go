@@ -6,6 +6,9 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"time"
 
 	db "go_server/database"
 	fc "go_server/fabric"
@@ -17,7 +20,6 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/emptypb"
 
 )
 
@@ -32,6 +34,21 @@ func (s *server) UploadReport(ctx context.Context, req *pb.UploadReportRequest)
 	return sc.HandleUploadReport(ctx, req, s.Wallet, s.Builder)
 }
 
+// AmendReport
+func (s *server) AmendReport(ctx context.Context, req *pb.AmendReportRequest) (*pb.AmendReportResponse, error) {
+	return sc.HandleAmendReport(ctx, req, s.Wallet, s.Builder)
+}
+
+// GetReportHistory
+func (s *server) GetReportHistory(ctx context.Context, req *pb.GetReportHistoryRequest) (*pb.GetReportHistoryResponse, error) {
+	return sc.HandleGetReportHistory(ctx, req, s.Wallet, s.Builder)
+}
+
+// GetReportAuditTrail
+func (s *server) GetReportAuditTrail(ctx context.Context, req *pb.GetReportAuditTrailRequest) (*pb.GetReportAuditTrailResponse, error) {
+	return sc.HandleGetReportAuditTrail(ctx, req, s.Wallet, s.Builder)
+}
+
 // Login
 func (s *server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	return sc.HandleLogin(ctx, req, s.Wallet)
@@ -49,9 +66,9 @@ func (s *server) RegisterInsurer(ctx context.Context, req *pb.RegisterInsurerReq
 
 
 // 新增 ListMyReportMeta API 方法
-func (s *server) ListMyReportMeta(ctx context.Context, in *emptypb.Empty) (*pb.ListMyReportMetaResponse, error) {
+func (s *server) ListMyReportMeta(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListMyReportMetaResponse, error) {
 	log.Printf("[Debug] ListMyReportMeta")
-	return sc.HandleListMyReportMeta(ctx, in, s.Wallet, s.Builder)
+	return sc.HandleListMyReportMeta(ctx, req, s.Wallet, s.Builder)
 }
 
 // 新增 ReadMyReport API 方法
@@ -66,8 +83,8 @@ func (s *server) RequestAccess(ctx context.Context, req *pb.RequestAccessRequest
 }
 
 // 新增 ListAccessRequests API 方法
-func (s *server) ListAccessRequests(ctx context.Context, in *emptypb.Empty) (*pb.ListAccessRequestsResponse, error) {
-	return sc.HandleListAccessRequests(ctx, in, s.Wallet, s.Builder)
+func (s *server) ListAccessRequests(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListAccessRequestsResponse, error) {
+	return sc.HandleListAccessRequests(ctx, req, s.Wallet, s.Builder)
 }
 
 // 新增 ApproveAccessRequest API 方法
@@ -80,11 +97,16 @@ func (s *server) RejectAccessRequest(ctx context.Context, req *pb.RejectAccessRe
 	return sc.HandleRejectAccessRequest(ctx, req, s.Wallet, s.Builder)
 }
 
+// 新增 RevokeAccessTicket API 方法
+func (s *server) RevokeAccessTicket(ctx context.Context, req *pb.RevokeAccessTicketRequest) (*pb.RevokeAccessTicketResponse, error) {
+	return sc.HandleRevokeAccessTicket(ctx, req, s.Wallet, s.Builder)
+}
+
 
 
 // 新增 ListAuthorizedReports API 方法
-func (s *server) ListAuthorizedReports(ctx context.Context, in *emptypb.Empty) (*pb.ListAuthorizedReportsResponse, error) {
-	return sc.HandleListAuthorizedReports(ctx, in, s.Wallet, s.Builder)
+func (s *server) ListAuthorizedReports(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListAuthorizedReportsResponse, error) {
+	return sc.HandleListAuthorizedReports(ctx, req, s.Wallet, s.Builder)
 }
 
 // 新增 ListReportMetaByPatientID API 方法
@@ -96,12 +118,170 @@ func (s *server) ViewAuthorizedReport(ctx context.Context, req *pb.ViewAuthorize
 	return sc.HandleViewAuthorizedReport(ctx, req, s.Wallet, s.Builder)
 }
 
-func (s *server) ListMyAccessRequests(ctx context.Context, in *emptypb.Empty) (*pb.ListMyAccessRequestsResponse, error) {
-	return sc.HandleListMyAccessRequests(ctx, in, s.Wallet, s.Builder)
+// 保險業者評估條件授權
+func (s *server) EvaluateAuthorizedPredicates(ctx context.Context, req *pb.EvaluateAuthorizedPredicatesRequest) (*pb.EvaluateAuthorizedPredicatesResponse, error) {
+	return sc.HandleEvaluateAuthorizedPredicates(ctx, req, s.Wallet, s.Builder)
+}
+
+func (s *server) ListMyAccessRequests(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListMyAccessRequestsResponse, error) {
+	return sc.HandleListMyAccessRequests(ctx, req, s.Wallet, s.Builder)
+}
+
+func (s *server) ListMyAuthorizedTickets(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListAuthorizedTicketsResponse, error) {
+	return sc.HandleListMyAuthorizedTickets(ctx, req, s.Wallet, s.Builder)
+}
+
+// 管理者登錄健檢中心
+func (s *server) RegisterClinic(ctx context.Context, req *pb.RegisterClinicRequest) (*pb.RegisterClinicResponse, error) {
+	return sc.HandleRegisterClinic(ctx, req, s.Wallet, s.Builder)
+}
+
+// 管理者停權健檢中心
+func (s *server) SuspendClinic(ctx context.Context, req *pb.SuspendClinicRequest) (*pb.SuspendClinicResponse, error) {
+	return sc.HandleSuspendClinic(ctx, req, s.Wallet, s.Builder)
+}
+
+// 查詢健檢中心登錄資料
+func (s *server) GetClinic(ctx context.Context, req *pb.GetClinicRequest) (*pb.GetClinicResponse, error) {
+	return sc.HandleGetClinic(ctx, req, s.Wallet, s.Builder)
+}
+
+// 管理者登錄保險業者
+func (s *server) RegisterInsurerLicense(ctx context.Context, req *pb.RegisterInsurerLicenseRequest) (*pb.RegisterInsurerLicenseResponse, error) {
+	return sc.HandleRegisterInsurerLicense(ctx, req, s.Wallet, s.Builder)
+}
+
+// 管理者停權保險業者
+func (s *server) SuspendInsurerLicense(ctx context.Context, req *pb.SuspendInsurerLicenseRequest) (*pb.SuspendInsurerLicenseResponse, error) {
+	return sc.HandleSuspendInsurerLicense(ctx, req, s.Wallet, s.Builder)
+}
+
+// 管理者查詢保險業者登錄資料
+func (s *server) GetInsurerLicense(ctx context.Context, req *pb.GetInsurerLicenseRequest) (*pb.GetInsurerLicenseResponse, error) {
+	return sc.HandleGetInsurerLicense(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患指定代理人
+func (s *server) GrantDelegate(ctx context.Context, req *pb.GrantDelegateRequest) (*pb.GrantDelegateResponse, error) {
+	return sc.HandleGrantDelegate(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患撤銷代理人
+func (s *server) RevokeDelegate(ctx context.Context, req *pb.RevokeDelegateRequest) (*pb.RevokeDelegateResponse, error) {
+	return sc.HandleRevokeDelegate(ctx, req, s.Wallet, s.Builder)
+}
+
+// 列出代理授權
+func (s *server) ListDelegations(ctx context.Context, req *pb.ListDelegationsRequest) (*pb.ListDelegationsResponse, error) {
+	return sc.HandleListDelegations(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患建立同意政策
+func (s *server) CreateConsentPolicy(ctx context.Context, req *pb.CreateConsentPolicyRequest) (*pb.ConsentPolicyResponse, error) {
+	return sc.HandleCreateConsentPolicy(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患更新同意政策
+func (s *server) UpdateConsentPolicy(ctx context.Context, req *pb.UpdateConsentPolicyRequest) (*pb.ConsentPolicyResponse, error) {
+	return sc.HandleUpdateConsentPolicy(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患撤銷同意政策
+func (s *server) RevokeConsentPolicy(ctx context.Context, req *pb.RevokeConsentPolicyRequest) (*pb.ConsentPolicyResponse, error) {
+	return sc.HandleRevokeConsentPolicy(ctx, req, s.Wallet, s.Builder)
+}
+
+// 列出同意政策
+func (s *server) ListConsentPolicies(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListConsentPoliciesResponse, error) {
+	return sc.HandleListConsentPolicies(ctx, req, s.Wallet, s.Builder)
+}
+
+// 急診人員緊急存取報告
+func (s *server) BreakGlassRead(ctx context.Context, req *pb.BreakGlassReadRequest) (*pb.BreakGlassReadResponse, error) {
+	return sc.HandleBreakGlassRead(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患查看緊急存取紀錄
+func (s *server) ListEmergencyAccesses(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListEmergencyAccessesResponse, error) {
+	return sc.HandleListEmergencyAccesses(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患確認緊急存取紀錄
+func (s *server) AcknowledgeEmergencyAccess(ctx context.Context, req *pb.AcknowledgeEmergencyAccessRequest) (*pb.AcknowledgeEmergencyAccessResponse, error) {
+	return sc.HandleAcknowledgeEmergencyAccess(ctx, req, s.Wallet, s.Builder)
+}
+
+// 保險業者請求延長既有票據
+func (s *server) RequestAccessExtension(ctx context.Context, req *pb.RequestAccessExtensionRequest) (*pb.RequestAccessResponse, error) {
+	return sc.HandleRequestAccessExtension(ctx, req, s.Wallet, s.Builder)
 }
 
-func (s *server) ListMyAuthorizedTickets(ctx context.Context, in *emptypb.Empty) (*pb.ListAuthorizedTicketsResponse, error) {
-	return sc.HandleListMyAuthorizedTickets(ctx, in, s.Wallet, s.Builder)
+// 保險業者查詢即將到期的票據
+func (s *server) ListExpiringTickets(ctx context.Context, req *pb.ListExpiringTicketsRequest) (*pb.ListAuthorizedTicketsResponse, error) {
+	return sc.HandleListExpiringTickets(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患查詢所有授權請求歷史
+func (s *server) ListMyAccessRequestHistory(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListAccessRequestsResponse, error) {
+	return sc.HandleListMyAccessRequestHistory(ctx, req, s.Wallet, s.Builder)
+}
+
+// 健檢中心查詢上傳的報告
+func (s *server) ListClinicReports(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListClinicReportsResponse, error) {
+	return sc.HandleListClinicReports(ctx, req, s.Wallet, s.Builder)
+}
+
+// 健檢中心儀表板
+func (s *server) GetClinicDashboard(ctx context.Context, req *pb.ListQueryRequest) (*pb.ClinicDashboardResponse, error) {
+	return sc.HandleGetClinicDashboard(ctx, req, s.Wallet, s.Builder)
+}
+
+// 以 FHIR R4 Bundle 匯出報告
+func (s *server) ExportReportFHIR(ctx context.Context, req *pb.ExportReportFHIRRequest) (*pb.ExportReportFHIRResponse, error) {
+	return sc.HandleExportReportFHIR(ctx, req, s.Wallet, s.Builder)
+}
+
+// 健檢中心維護檢驗代碼對應
+func (s *server) UpsertAnalyteMapping(ctx context.Context, req *pb.UpsertAnalyteMappingRequest) (*pb.AnalyteMappingResponse, error) {
+	return sc.HandleUpsertAnalyteMapping(ctx, req, s.Wallet)
+}
+
+func (s *server) DeleteAnalyteMapping(ctx context.Context, req *pb.DeleteAnalyteMappingRequest) (*pb.AnalyteMappingResponse, error) {
+	return sc.HandleDeleteAnalyteMapping(ctx, req, s.Wallet)
+}
+
+func (s *server) ListAnalyteMappings(ctx context.Context, req *pb.ListAnalyteMappingsRequest) (*pb.ListAnalyteMappingsResponse, error) {
+	return sc.HandleListAnalyteMappings(ctx, req, s.Wallet)
+}
+
+// 查詢內建檢驗項目字典
+func (s *server) ListAnalyteDictionary(ctx context.Context, req *pb.ListAnalyteDictionaryRequest) (*pb.ListAnalyteDictionaryResponse, error) {
+	return sc.HandleListAnalyteDictionary(ctx, req)
+}
+
+// 健檢中心匯入 HL7 v2 ORU 訊息或 FHIR Bundle
+func (s *server) IngestLabResults(ctx context.Context, req *pb.IngestLabResultsRequest) (*pb.IngestLabResultsResponse, error) {
+	return sc.HandleIngestLabResults(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患查詢報告讀取紀錄
+func (s *server) ListAccessLog(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListAccessLogResponse, error) {
+	return sc.HandleListAccessLog(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患封鎖保險業者
+func (s *server) BlockRequester(ctx context.Context, req *pb.BlockRequesterRequest) (*pb.BlockRequesterResponse, error) {
+	return sc.HandleBlockRequester(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患解除封鎖
+func (s *server) UnblockRequester(ctx context.Context, req *pb.UnblockRequesterRequest) (*pb.BlockRequesterResponse, error) {
+	return sc.HandleUnblockRequester(ctx, req, s.Wallet, s.Builder)
+}
+
+// 病患查詢封鎖中的保險業者
+func (s *server) ListBlockedRequesters(ctx context.Context, req *pb.ListQueryRequest) (*pb.ListBlockedRequestersResponse, error) {
+	return sc.HandleListBlockedRequesters(ctx, req, s.Wallet, s.Builder)
 }
 
 func main() {
@@ -140,10 +320,74 @@ func main() {
 		log.Println("✅ Gateway 連線測試成功")
 	}
 
+	systemID := envOr("SYSTEM_IDENTITY", "system")
+
+	// 鏈上授權請求設定（使用 system 身份）
+	sc.ApplyAccessRequestSettings(w, builder, systemID, sc.AccessRequestSettings{
+		ResponseWindow: envDuration("ACCESS_REQUEST_RESPONSE_WINDOW", 0),
+		MaxPending:     envInt("ACCESS_REQUEST_MAX_PENDING", 0),
+		RejectCooldown: envDuration("ACCESS_REQUEST_REJECT_COOLDOWN", 0),
+	})
+
+	// 授權請求逾期排程（使用 system 身份）
+	go sc.StartAccessRequestSweeper(
+		context.Background(),
+		w,
+		builder,
+		systemID,
+		envPositiveDuration("ACCESS_REQUEST_SWEEP_INTERVAL", sc.DefaultSweepInterval),
+	)
+
 	go startGrpcServer(w, builder) // 開 gRPC server
 	startHttpGatewayServer()       // 開 gRPC-Gateway server (HTTP server)
 }
 
+// 讀取環境變數，未設定時使用預設值
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// 讀取時間長度環境變數（例如 30m、168h），格式錯誤時使用預設值
+func envDuration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		log.Printf("⚠️ %s 格式錯誤 (%s)，使用預設值 %s", key, v, def)
+		return def
+	}
+	return d
+}
+
+// 讀取必須大於 0 的時間長度環境變數（例如排程間隔），格式錯誤或不大於 0 時使用預設值
+func envPositiveDuration(key string, def time.Duration) time.Duration {
+	d := envDuration(key, def)
+	if d <= 0 {
+		log.Printf("⚠️ %s 必須大於 0 (%s)，使用預設值 %s", key, os.Getenv(key), def)
+		return def
+	}
+	return d
+}
+
+// 讀取整數環境變數，格式錯誤時使用預設值
+func envInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		log.Printf("⚠️ %s 格式錯誤 (%s)，使用預設值 %d", key, v, def)
+		return def
+	}
+	return n
+}
+
 // 添加Gateway連線測試函數
 func testGatewayConnection(builder fc.GWBuilder, wallet *wl.Wallet) error {
 	// 嘗試使用現有的用戶身份測試連線
@@ -167,7 +411,7 @@ func testGatewayConnection(builder fc.GWBuilder, wallet *wl.Wallet) error {
 	defer gw.Close()
 
 	// 嘗試評估一個簡單的chaincode函數
-	_, err = contract.EvaluateTransaction("ListMyReportMeta")
+	_, err = contract.EvaluateTransaction("ListMyReportMeta", "1", "", "{}")
 	if err != nil {
 		return fmt.Errorf("chaincode 調用失敗: %w", err)
 	}
@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,21 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	HealthService_UploadReport_FullMethodName              = "/health.HealthService/UploadReport"
-	HealthService_Login_FullMethodName                     = "/health.HealthService/Login"
-	HealthService_RegisterUser_FullMethodName              = "/health.HealthService/RegisterUser"
-	HealthService_RegisterInsurer_FullMethodName           = "/health.HealthService/RegisterInsurer"
-	HealthService_ListMyReportMeta_FullMethodName          = "/health.HealthService/ListMyReportMeta"
-	HealthService_ReadMyReport_FullMethodName              = "/health.HealthService/ReadMyReport"
-	HealthService_ListMyAuthorizedTickets_FullMethodName   = "/health.HealthService/ListMyAuthorizedTickets"
-	HealthService_RequestAccess_FullMethodName             = "/health.HealthService/RequestAccess"
-	HealthService_ListAccessRequests_FullMethodName        = "/health.HealthService/ListAccessRequests"
-	HealthService_ApproveAccessRequest_FullMethodName      = "/health.HealthService/ApproveAccessRequest"
-	HealthService_RejectAccessRequest_FullMethodName       = "/health.HealthService/RejectAccessRequest"
-	HealthService_ListAuthorizedReports_FullMethodName     = "/health.HealthService/ListAuthorizedReports"
-	HealthService_ListReportMetaByPatientID_FullMethodName = "/health.HealthService/ListReportMetaByPatientID"
-	HealthService_ViewAuthorizedReport_FullMethodName      = "/health.HealthService/ViewAuthorizedReport"
-	HealthService_ListMyAccessRequests_FullMethodName      = "/health.HealthService/ListMyAccessRequests"
+	HealthService_UploadReport_FullMethodName                 = "/health.HealthService/UploadReport"
+	HealthService_AmendReport_FullMethodName                  = "/health.HealthService/AmendReport"
+	HealthService_GetReportHistory_FullMethodName             = "/health.HealthService/GetReportHistory"
+	HealthService_GetReportAuditTrail_FullMethodName          = "/health.HealthService/GetReportAuditTrail"
+	HealthService_Login_FullMethodName                        = "/health.HealthService/Login"
+	HealthService_RegisterUser_FullMethodName                 = "/health.HealthService/RegisterUser"
+	HealthService_RegisterInsurer_FullMethodName              = "/health.HealthService/RegisterInsurer"
+	HealthService_ListMyReportMeta_FullMethodName             = "/health.HealthService/ListMyReportMeta"
+	HealthService_ReadMyReport_FullMethodName                 = "/health.HealthService/ReadMyReport"
+	HealthService_ListMyAuthorizedTickets_FullMethodName      = "/health.HealthService/ListMyAuthorizedTickets"
+	HealthService_RequestAccess_FullMethodName                = "/health.HealthService/RequestAccess"
+	HealthService_RequestAccessExtension_FullMethodName       = "/health.HealthService/RequestAccessExtension"
+	HealthService_ListExpiringTickets_FullMethodName          = "/health.HealthService/ListExpiringTickets"
+	HealthService_ListAccessRequests_FullMethodName           = "/health.HealthService/ListAccessRequests"
+	HealthService_ListMyAccessRequestHistory_FullMethodName   = "/health.HealthService/ListMyAccessRequestHistory"
+	HealthService_ApproveAccessRequest_FullMethodName         = "/health.HealthService/ApproveAccessRequest"
+	HealthService_RejectAccessRequest_FullMethodName          = "/health.HealthService/RejectAccessRequest"
+	HealthService_RevokeAccessTicket_FullMethodName           = "/health.HealthService/RevokeAccessTicket"
+	HealthService_ListAuthorizedReports_FullMethodName        = "/health.HealthService/ListAuthorizedReports"
+	HealthService_ListReportMetaByPatientID_FullMethodName    = "/health.HealthService/ListReportMetaByPatientID"
+	HealthService_ViewAuthorizedReport_FullMethodName         = "/health.HealthService/ViewAuthorizedReport"
+	HealthService_ExportReportFHIR_FullMethodName             = "/health.HealthService/ExportReportFHIR"
+	HealthService_EvaluateAuthorizedPredicates_FullMethodName = "/health.HealthService/EvaluateAuthorizedPredicates"
+	HealthService_RegisterClinic_FullMethodName               = "/health.HealthService/RegisterClinic"
+	HealthService_SuspendClinic_FullMethodName                = "/health.HealthService/SuspendClinic"
+	HealthService_GetClinic_FullMethodName                    = "/health.HealthService/GetClinic"
+	HealthService_RegisterInsurerLicense_FullMethodName       = "/health.HealthService/RegisterInsurerLicense"
+	HealthService_SuspendInsurerLicense_FullMethodName        = "/health.HealthService/SuspendInsurerLicense"
+	HealthService_GetInsurerLicense_FullMethodName            = "/health.HealthService/GetInsurerLicense"
+	HealthService_ListMyAccessRequests_FullMethodName         = "/health.HealthService/ListMyAccessRequests"
+	HealthService_GrantDelegate_FullMethodName                = "/health.HealthService/GrantDelegate"
+	HealthService_RevokeDelegate_FullMethodName               = "/health.HealthService/RevokeDelegate"
+	HealthService_ListDelegations_FullMethodName              = "/health.HealthService/ListDelegations"
+	HealthService_CreateConsentPolicy_FullMethodName          = "/health.HealthService/CreateConsentPolicy"
+	HealthService_UpdateConsentPolicy_FullMethodName          = "/health.HealthService/UpdateConsentPolicy"
+	HealthService_RevokeConsentPolicy_FullMethodName          = "/health.HealthService/RevokeConsentPolicy"
+	HealthService_ListConsentPolicies_FullMethodName          = "/health.HealthService/ListConsentPolicies"
+	HealthService_ListClinicReports_FullMethodName            = "/health.HealthService/ListClinicReports"
+	HealthService_GetClinicDashboard_FullMethodName           = "/health.HealthService/GetClinicDashboard"
+	HealthService_UpsertAnalyteMapping_FullMethodName         = "/health.HealthService/UpsertAnalyteMapping"
+	HealthService_DeleteAnalyteMapping_FullMethodName         = "/health.HealthService/DeleteAnalyteMapping"
+	HealthService_ListAnalyteMappings_FullMethodName          = "/health.HealthService/ListAnalyteMappings"
+	HealthService_ListAnalyteDictionary_FullMethodName        = "/health.HealthService/ListAnalyteDictionary"
+	HealthService_IngestLabResults_FullMethodName             = "/health.HealthService/IngestLabResults"
+	HealthService_ListAccessLog_FullMethodName                = "/health.HealthService/ListAccessLog"
+	HealthService_BlockRequester_FullMethodName               = "/health.HealthService/BlockRequester"
+	HealthService_UnblockRequester_FullMethodName             = "/health.HealthService/UnblockRequester"
+	HealthService_ListBlockedRequesters_FullMethodName        = "/health.HealthService/ListBlockedRequesters"
+	HealthService_BreakGlassRead_FullMethodName               = "/health.HealthService/BreakGlassRead"
+	HealthService_ListEmergencyAccesses_FullMethodName        = "/health.HealthService/ListEmergencyAccesses"
+	HealthService_AcknowledgeEmergencyAccess_FullMethodName   = "/health.HealthService/AcknowledgeEmergencyAccess"
 )
 
 // HealthServiceClient is the client API for HealthService service.
@@ -43,6 +78,12 @@ const (
 type HealthServiceClient interface {
 	// 健檢中心上傳報告
 	UploadReport(ctx context.Context, in *UploadReportRequest, opts ...grpc.CallOption) (*UploadReportResponse, error)
+	// 健檢中心更正已上傳的報告（寫入新版本）
+	AmendReport(ctx context.Context, in *AmendReportRequest, opts ...grpc.CallOption) (*AmendReportResponse, error)
+	// 查詢報告的歷史版本
+	GetReportHistory(ctx context.Context, in *GetReportHistoryRequest, opts ...grpc.CallOption) (*GetReportHistoryResponse, error)
+	// 查詢報告的稽核軌跡（病患本人或上傳的健檢中心）
+	GetReportAuditTrail(ctx context.Context, in *GetReportAuditTrailRequest, opts ...grpc.CallOption) (*GetReportAuditTrailResponse, error)
 	// 登入
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// 用戶註冊
@@ -50,25 +91,91 @@ type HealthServiceClient interface {
 	// 保險業者註冊
 	RegisterInsurer(ctx context.Context, in *RegisterInsurerRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	// 用戶讀取自己的報告 meta (只有metadata，不包含內容)
-	ListMyReportMeta(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMyReportMetaResponse, error)
+	ListMyReportMeta(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListMyReportMetaResponse, error)
 	// 用戶讀取自己的完整報告內容
 	ReadMyReport(ctx context.Context, in *ReadMyReportRequest, opts ...grpc.CallOption) (*ReadMyReportResponse, error)
 	// 用戶讀取授權紀錄
-	ListMyAuthorizedTickets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error)
+	ListMyAuthorizedTickets(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error)
 	// 請求授權
 	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error)
+	// 請求延長既有票據的期限
+	RequestAccessExtension(ctx context.Context, in *RequestAccessExtensionRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error)
+	// 保險業者查詢即將到期的票據
+	ListExpiringTickets(ctx context.Context, in *ListExpiringTicketsRequest, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error)
 	// 請求授權列表
-	ListAccessRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	// 病患查詢所有授權請求（含已處理），附上票據目前狀態
+	ListMyAccessRequestHistory(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
 	// 接受授權
 	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
 	// 拒絕授權
 	RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*RejectAccessRequestResponse, error)
-	ListAuthorizedReports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAuthorizedReportsResponse, error)
+	// 撤銷已核發的授權
+	RevokeAccessTicket(ctx context.Context, in *RevokeAccessTicketRequest, opts ...grpc.CallOption) (*RevokeAccessTicketResponse, error)
+	ListAuthorizedReports(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAuthorizedReportsResponse, error)
 	ListReportMetaByPatientID(ctx context.Context, in *PatientIDRequest, opts ...grpc.CallOption) (*ListReportMetaResponse, error)
 	// 保險業者讀授權報告
 	ViewAuthorizedReport(ctx context.Context, in *ViewAuthorizedReportRequest, opts ...grpc.CallOption) (*ViewAuthorizedReportResponse, error)
+	// 以 FHIR R4 Bundle 匯出報告：病患讀取自己的報告，保險業者憑授權票據讀取（同樣寫入讀取收據）
+	ExportReportFHIR(ctx context.Context, in *ExportReportFHIRRequest, opts ...grpc.CallOption) (*ExportReportFHIRResponse, error)
+	// 保險業者評估條件授權（只回傳布林結果）
+	EvaluateAuthorizedPredicates(ctx context.Context, in *EvaluateAuthorizedPredicatesRequest, opts ...grpc.CallOption) (*EvaluateAuthorizedPredicatesResponse, error)
+	// 管理者登錄或更新健檢中心
+	RegisterClinic(ctx context.Context, in *RegisterClinicRequest, opts ...grpc.CallOption) (*RegisterClinicResponse, error)
+	// 管理者停權健檢中心
+	SuspendClinic(ctx context.Context, in *SuspendClinicRequest, opts ...grpc.CallOption) (*SuspendClinicResponse, error)
+	// 查詢健檢中心登錄資料
+	GetClinic(ctx context.Context, in *GetClinicRequest, opts ...grpc.CallOption) (*GetClinicResponse, error)
+	// 管理者登錄或更新保險業者
+	RegisterInsurerLicense(ctx context.Context, in *RegisterInsurerLicenseRequest, opts ...grpc.CallOption) (*RegisterInsurerLicenseResponse, error)
+	// 管理者停權保險業者
+	SuspendInsurerLicense(ctx context.Context, in *SuspendInsurerLicenseRequest, opts ...grpc.CallOption) (*SuspendInsurerLicenseResponse, error)
+	// 管理者查詢保險業者登錄資料
+	GetInsurerLicense(ctx context.Context, in *GetInsurerLicenseRequest, opts ...grpc.CallOption) (*GetInsurerLicenseResponse, error)
 	// 保險業者查看自己發出的授權請求
-	ListMyAccessRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMyAccessRequestsResponse, error)
+	ListMyAccessRequests(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListMyAccessRequestsResponse, error)
+	// 病患指定代理人（監護人或照護者）
+	GrantDelegate(ctx context.Context, in *GrantDelegateRequest, opts ...grpc.CallOption) (*GrantDelegateResponse, error)
+	// 病患撤銷代理人
+	RevokeDelegate(ctx context.Context, in *RevokeDelegateRequest, opts ...grpc.CallOption) (*RevokeDelegateResponse, error)
+	// 列出自己指定的代理人，或自己可代理的病患
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	// 病患建立同意政策，預先授權保險業者
+	CreateConsentPolicy(ctx context.Context, in *CreateConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error)
+	// 病患更新同意政策
+	UpdateConsentPolicy(ctx context.Context, in *UpdateConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error)
+	// 病患撤銷同意政策
+	RevokeConsentPolicy(ctx context.Context, in *RevokeConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error)
+	// 列出同意政策（病患為自己建立的，保險業者為以自己為對象的），status 可為 ACTIVE / REVOKED
+	ListConsentPolicies(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListConsentPoliciesResponse, error)
+	// 健檢中心查詢自己上傳的報告，供與 LIS 對帳
+	ListClinicReports(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListClinicReportsResponse, error)
+	// 健檢中心儀表板：期間內上傳數、更正數與報告摘要
+	GetClinicDashboard(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ClinicDashboardResponse, error)
+	// 健檢中心（或平台管理者代為）新增或更新自訂檢驗代碼與 LOINC 代碼的對應
+	UpsertAnalyteMapping(ctx context.Context, in *UpsertAnalyteMappingRequest, opts ...grpc.CallOption) (*AnalyteMappingResponse, error)
+	// 刪除自訂檢驗代碼對應，之後改用內建對應
+	DeleteAnalyteMapping(ctx context.Context, in *DeleteAnalyteMappingRequest, opts ...grpc.CallOption) (*AnalyteMappingResponse, error)
+	// 列出健檢中心的自訂檢驗代碼對應
+	ListAnalyteMappings(ctx context.Context, in *ListAnalyteMappingsRequest, opts ...grpc.CallOption) (*ListAnalyteMappingsResponse, error)
+	// 查詢內建檢驗項目字典（LOINC 代碼、標準單位與中英文名稱）
+	ListAnalyteDictionary(ctx context.Context, in *ListAnalyteDictionaryRequest, opts ...grpc.CallOption) (*ListAnalyteDictionaryResponse, error)
+	// 健檢中心匯入檢驗系統的 HL7 v2 ORU^R01 訊息或 FHIR Bundle，逐份報告回報接受或拒絕
+	IngestLabResults(ctx context.Context, in *IngestLabResultsRequest, opts ...grpc.CallOption) (*IngestLabResultsResponse, error)
+	// 病患查詢保險業者讀取自己報告的紀錄
+	ListAccessLog(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessLogResponse, error)
+	// 病患封鎖保險業者，封鎖期間鏈碼拒絕其授權請求
+	BlockRequester(ctx context.Context, in *BlockRequesterRequest, opts ...grpc.CallOption) (*BlockRequesterResponse, error)
+	// 病患解除封鎖
+	UnblockRequester(ctx context.Context, in *UnblockRequesterRequest, opts ...grpc.CallOption) (*BlockRequesterResponse, error)
+	// 病患查看封鎖中的保險業者
+	ListBlockedRequesters(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListBlockedRequestersResponse, error)
+	// 緊急存取：病患無法核准時由 emergency 身份讀取報告，存取紀錄寫入鏈上
+	BreakGlassRead(ctx context.Context, in *BreakGlassReadRequest, opts ...grpc.CallOption) (*BreakGlassReadResponse, error)
+	// 病患查看自己報告的緊急存取紀錄
+	ListEmergencyAccesses(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListEmergencyAccessesResponse, error)
+	// 病患確認緊急存取紀錄
+	AcknowledgeEmergencyAccess(ctx context.Context, in *AcknowledgeEmergencyAccessRequest, opts ...grpc.CallOption) (*AcknowledgeEmergencyAccessResponse, error)
 }
 
 type healthServiceClient struct {
@@ -89,6 +196,36 @@ func (c *healthServiceClient) UploadReport(ctx context.Context, in *UploadReport
 	return out, nil
 }
 
+func (c *healthServiceClient) AmendReport(ctx context.Context, in *AmendReportRequest, opts ...grpc.CallOption) (*AmendReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendReportResponse)
+	err := c.cc.Invoke(ctx, HealthService_AmendReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetReportHistory(ctx context.Context, in *GetReportHistoryRequest, opts ...grpc.CallOption) (*GetReportHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportHistoryResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetReportHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetReportAuditTrail(ctx context.Context, in *GetReportAuditTrailRequest, opts ...grpc.CallOption) (*GetReportAuditTrailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportAuditTrailResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetReportAuditTrail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -119,7 +256,7 @@ func (c *healthServiceClient) RegisterInsurer(ctx context.Context, in *RegisterI
 	return out, nil
 }
 
-func (c *healthServiceClient) ListMyReportMeta(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMyReportMetaResponse, error) {
+func (c *healthServiceClient) ListMyReportMeta(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListMyReportMetaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMyReportMetaResponse)
 	err := c.cc.Invoke(ctx, HealthService_ListMyReportMeta_FullMethodName, in, out, cOpts...)
@@ -139,7 +276,7 @@ func (c *healthServiceClient) ReadMyReport(ctx context.Context, in *ReadMyReport
 	return out, nil
 }
 
-func (c *healthServiceClient) ListMyAuthorizedTickets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error) {
+func (c *healthServiceClient) ListMyAuthorizedTickets(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAuthorizedTicketsResponse)
 	err := c.cc.Invoke(ctx, HealthService_ListMyAuthorizedTickets_FullMethodName, in, out, cOpts...)
@@ -159,7 +296,27 @@ func (c *healthServiceClient) RequestAccess(ctx context.Context, in *RequestAcce
 	return out, nil
 }
 
-func (c *healthServiceClient) ListAccessRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+func (c *healthServiceClient) RequestAccessExtension(ctx context.Context, in *RequestAccessExtensionRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccessResponse)
+	err := c.cc.Invoke(ctx, HealthService_RequestAccessExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListExpiringTickets(ctx context.Context, in *ListExpiringTicketsRequest, opts ...grpc.CallOption) (*ListAuthorizedTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorizedTicketsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListExpiringTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAccessRequests(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAccessRequestsResponse)
 	err := c.cc.Invoke(ctx, HealthService_ListAccessRequests_FullMethodName, in, out, cOpts...)
@@ -169,6 +326,16 @@ func (c *healthServiceClient) ListAccessRequests(ctx context.Context, in *emptyp
 	return out, nil
 }
 
+func (c *healthServiceClient) ListMyAccessRequestHistory(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListMyAccessRequestHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApproveAccessRequestResponse)
@@ -189,7 +356,17 @@ func (c *healthServiceClient) RejectAccessRequest(ctx context.Context, in *Rejec
 	return out, nil
 }
 
-func (c *healthServiceClient) ListAuthorizedReports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAuthorizedReportsResponse, error) {
+func (c *healthServiceClient) RevokeAccessTicket(ctx context.Context, in *RevokeAccessTicketRequest, opts ...grpc.CallOption) (*RevokeAccessTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccessTicketResponse)
+	err := c.cc.Invoke(ctx, HealthService_RevokeAccessTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAuthorizedReports(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAuthorizedReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAuthorizedReportsResponse)
 	err := c.cc.Invoke(ctx, HealthService_ListAuthorizedReports_FullMethodName, in, out, cOpts...)
@@ -219,7 +396,87 @@ func (c *healthServiceClient) ViewAuthorizedReport(ctx context.Context, in *View
 	return out, nil
 }
 
-func (c *healthServiceClient) ListMyAccessRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMyAccessRequestsResponse, error) {
+func (c *healthServiceClient) ExportReportFHIR(ctx context.Context, in *ExportReportFHIRRequest, opts ...grpc.CallOption) (*ExportReportFHIRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportReportFHIRResponse)
+	err := c.cc.Invoke(ctx, HealthService_ExportReportFHIR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) EvaluateAuthorizedPredicates(ctx context.Context, in *EvaluateAuthorizedPredicatesRequest, opts ...grpc.CallOption) (*EvaluateAuthorizedPredicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateAuthorizedPredicatesResponse)
+	err := c.cc.Invoke(ctx, HealthService_EvaluateAuthorizedPredicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) RegisterClinic(ctx context.Context, in *RegisterClinicRequest, opts ...grpc.CallOption) (*RegisterClinicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterClinicResponse)
+	err := c.cc.Invoke(ctx, HealthService_RegisterClinic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) SuspendClinic(ctx context.Context, in *SuspendClinicRequest, opts ...grpc.CallOption) (*SuspendClinicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendClinicResponse)
+	err := c.cc.Invoke(ctx, HealthService_SuspendClinic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetClinic(ctx context.Context, in *GetClinicRequest, opts ...grpc.CallOption) (*GetClinicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClinicResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetClinic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) RegisterInsurerLicense(ctx context.Context, in *RegisterInsurerLicenseRequest, opts ...grpc.CallOption) (*RegisterInsurerLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterInsurerLicenseResponse)
+	err := c.cc.Invoke(ctx, HealthService_RegisterInsurerLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) SuspendInsurerLicense(ctx context.Context, in *SuspendInsurerLicenseRequest, opts ...grpc.CallOption) (*SuspendInsurerLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendInsurerLicenseResponse)
+	err := c.cc.Invoke(ctx, HealthService_SuspendInsurerLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetInsurerLicense(ctx context.Context, in *GetInsurerLicenseRequest, opts ...grpc.CallOption) (*GetInsurerLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInsurerLicenseResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetInsurerLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListMyAccessRequests(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListMyAccessRequestsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMyAccessRequestsResponse)
 	err := c.cc.Invoke(ctx, HealthService_ListMyAccessRequests_FullMethodName, in, out, cOpts...)
@@ -229,12 +486,228 @@ func (c *healthServiceClient) ListMyAccessRequests(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *healthServiceClient) GrantDelegate(ctx context.Context, in *GrantDelegateRequest, opts ...grpc.CallOption) (*GrantDelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantDelegateResponse)
+	err := c.cc.Invoke(ctx, HealthService_GrantDelegate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) RevokeDelegate(ctx context.Context, in *RevokeDelegateRequest, opts ...grpc.CallOption) (*RevokeDelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDelegateResponse)
+	err := c.cc.Invoke(ctx, HealthService_RevokeDelegate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) CreateConsentPolicy(ctx context.Context, in *CreateConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsentPolicyResponse)
+	err := c.cc.Invoke(ctx, HealthService_CreateConsentPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) UpdateConsentPolicy(ctx context.Context, in *UpdateConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsentPolicyResponse)
+	err := c.cc.Invoke(ctx, HealthService_UpdateConsentPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) RevokeConsentPolicy(ctx context.Context, in *RevokeConsentPolicyRequest, opts ...grpc.CallOption) (*ConsentPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsentPolicyResponse)
+	err := c.cc.Invoke(ctx, HealthService_RevokeConsentPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListConsentPolicies(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListConsentPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConsentPoliciesResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListConsentPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListClinicReports(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListClinicReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClinicReportsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListClinicReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetClinicDashboard(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ClinicDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClinicDashboardResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetClinicDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) UpsertAnalyteMapping(ctx context.Context, in *UpsertAnalyteMappingRequest, opts ...grpc.CallOption) (*AnalyteMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyteMappingResponse)
+	err := c.cc.Invoke(ctx, HealthService_UpsertAnalyteMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) DeleteAnalyteMapping(ctx context.Context, in *DeleteAnalyteMappingRequest, opts ...grpc.CallOption) (*AnalyteMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyteMappingResponse)
+	err := c.cc.Invoke(ctx, HealthService_DeleteAnalyteMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAnalyteMappings(ctx context.Context, in *ListAnalyteMappingsRequest, opts ...grpc.CallOption) (*ListAnalyteMappingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnalyteMappingsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListAnalyteMappings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAnalyteDictionary(ctx context.Context, in *ListAnalyteDictionaryRequest, opts ...grpc.CallOption) (*ListAnalyteDictionaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnalyteDictionaryResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListAnalyteDictionary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) IngestLabResults(ctx context.Context, in *IngestLabResultsRequest, opts ...grpc.CallOption) (*IngestLabResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestLabResultsResponse)
+	err := c.cc.Invoke(ctx, HealthService_IngestLabResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAccessLog(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListAccessLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessLogResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListAccessLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) BlockRequester(ctx context.Context, in *BlockRequesterRequest, opts ...grpc.CallOption) (*BlockRequesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockRequesterResponse)
+	err := c.cc.Invoke(ctx, HealthService_BlockRequester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) UnblockRequester(ctx context.Context, in *UnblockRequesterRequest, opts ...grpc.CallOption) (*BlockRequesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockRequesterResponse)
+	err := c.cc.Invoke(ctx, HealthService_UnblockRequester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListBlockedRequesters(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListBlockedRequestersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedRequestersResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListBlockedRequesters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) BreakGlassRead(ctx context.Context, in *BreakGlassReadRequest, opts ...grpc.CallOption) (*BreakGlassReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BreakGlassReadResponse)
+	err := c.cc.Invoke(ctx, HealthService_BreakGlassRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListEmergencyAccesses(ctx context.Context, in *ListQueryRequest, opts ...grpc.CallOption) (*ListEmergencyAccessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergencyAccessesResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListEmergencyAccesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) AcknowledgeEmergencyAccess(ctx context.Context, in *AcknowledgeEmergencyAccessRequest, opts ...grpc.CallOption) (*AcknowledgeEmergencyAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeEmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, HealthService_AcknowledgeEmergencyAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility
 type HealthServiceServer interface {
 	// 健檢中心上傳報告
 	UploadReport(context.Context, *UploadReportRequest) (*UploadReportResponse, error)
+	// 健檢中心更正已上傳的報告（寫入新版本）
+	AmendReport(context.Context, *AmendReportRequest) (*AmendReportResponse, error)
+	// 查詢報告的歷史版本
+	GetReportHistory(context.Context, *GetReportHistoryRequest) (*GetReportHistoryResponse, error)
+	// 查詢報告的稽核軌跡（病患本人或上傳的健檢中心）
+	GetReportAuditTrail(context.Context, *GetReportAuditTrailRequest) (*GetReportAuditTrailResponse, error)
 	// 登入
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	// 用戶註冊
@@ -242,25 +715,91 @@ type HealthServiceServer interface {
 	// 保險業者註冊
 	RegisterInsurer(context.Context, *RegisterInsurerRequest) (*RegisterResponse, error)
 	// 用戶讀取自己的報告 meta (只有metadata，不包含內容)
-	ListMyReportMeta(context.Context, *emptypb.Empty) (*ListMyReportMetaResponse, error)
+	ListMyReportMeta(context.Context, *ListQueryRequest) (*ListMyReportMetaResponse, error)
 	// 用戶讀取自己的完整報告內容
 	ReadMyReport(context.Context, *ReadMyReportRequest) (*ReadMyReportResponse, error)
 	// 用戶讀取授權紀錄
-	ListMyAuthorizedTickets(context.Context, *emptypb.Empty) (*ListAuthorizedTicketsResponse, error)
+	ListMyAuthorizedTickets(context.Context, *ListQueryRequest) (*ListAuthorizedTicketsResponse, error)
 	// 請求授權
 	RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error)
+	// 請求延長既有票據的期限
+	RequestAccessExtension(context.Context, *RequestAccessExtensionRequest) (*RequestAccessResponse, error)
+	// 保險業者查詢即將到期的票據
+	ListExpiringTickets(context.Context, *ListExpiringTicketsRequest) (*ListAuthorizedTicketsResponse, error)
 	// 請求授權列表
-	ListAccessRequests(context.Context, *emptypb.Empty) (*ListAccessRequestsResponse, error)
+	ListAccessRequests(context.Context, *ListQueryRequest) (*ListAccessRequestsResponse, error)
+	// 病患查詢所有授權請求（含已處理），附上票據目前狀態
+	ListMyAccessRequestHistory(context.Context, *ListQueryRequest) (*ListAccessRequestsResponse, error)
 	// 接受授權
 	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
 	// 拒絕授權
 	RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error)
-	ListAuthorizedReports(context.Context, *emptypb.Empty) (*ListAuthorizedReportsResponse, error)
+	// 撤銷已核發的授權
+	RevokeAccessTicket(context.Context, *RevokeAccessTicketRequest) (*RevokeAccessTicketResponse, error)
+	ListAuthorizedReports(context.Context, *ListQueryRequest) (*ListAuthorizedReportsResponse, error)
 	ListReportMetaByPatientID(context.Context, *PatientIDRequest) (*ListReportMetaResponse, error)
 	// 保險業者讀授權報告
 	ViewAuthorizedReport(context.Context, *ViewAuthorizedReportRequest) (*ViewAuthorizedReportResponse, error)
+	// 以 FHIR R4 Bundle 匯出報告：病患讀取自己的報告，保險業者憑授權票據讀取（同樣寫入讀取收據）
+	ExportReportFHIR(context.Context, *ExportReportFHIRRequest) (*ExportReportFHIRResponse, error)
+	// 保險業者評估條件授權（只回傳布林結果）
+	EvaluateAuthorizedPredicates(context.Context, *EvaluateAuthorizedPredicatesRequest) (*EvaluateAuthorizedPredicatesResponse, error)
+	// 管理者登錄或更新健檢中心
+	RegisterClinic(context.Context, *RegisterClinicRequest) (*RegisterClinicResponse, error)
+	// 管理者停權健檢中心
+	SuspendClinic(context.Context, *SuspendClinicRequest) (*SuspendClinicResponse, error)
+	// 查詢健檢中心登錄資料
+	GetClinic(context.Context, *GetClinicRequest) (*GetClinicResponse, error)
+	// 管理者登錄或更新保險業者
+	RegisterInsurerLicense(context.Context, *RegisterInsurerLicenseRequest) (*RegisterInsurerLicenseResponse, error)
+	// 管理者停權保險業者
+	SuspendInsurerLicense(context.Context, *SuspendInsurerLicenseRequest) (*SuspendInsurerLicenseResponse, error)
+	// 管理者查詢保險業者登錄資料
+	GetInsurerLicense(context.Context, *GetInsurerLicenseRequest) (*GetInsurerLicenseResponse, error)
 	// 保險業者查看自己發出的授權請求
-	ListMyAccessRequests(context.Context, *emptypb.Empty) (*ListMyAccessRequestsResponse, error)
+	ListMyAccessRequests(context.Context, *ListQueryRequest) (*ListMyAccessRequestsResponse, error)
+	// 病患指定代理人（監護人或照護者）
+	GrantDelegate(context.Context, *GrantDelegateRequest) (*GrantDelegateResponse, error)
+	// 病患撤銷代理人
+	RevokeDelegate(context.Context, *RevokeDelegateRequest) (*RevokeDelegateResponse, error)
+	// 列出自己指定的代理人，或自己可代理的病患
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	// 病患建立同意政策，預先授權保險業者
+	CreateConsentPolicy(context.Context, *CreateConsentPolicyRequest) (*ConsentPolicyResponse, error)
+	// 病患更新同意政策
+	UpdateConsentPolicy(context.Context, *UpdateConsentPolicyRequest) (*ConsentPolicyResponse, error)
+	// 病患撤銷同意政策
+	RevokeConsentPolicy(context.Context, *RevokeConsentPolicyRequest) (*ConsentPolicyResponse, error)
+	// 列出同意政策（病患為自己建立的，保險業者為以自己為對象的），status 可為 ACTIVE / REVOKED
+	ListConsentPolicies(context.Context, *ListQueryRequest) (*ListConsentPoliciesResponse, error)
+	// 健檢中心查詢自己上傳的報告，供與 LIS 對帳
+	ListClinicReports(context.Context, *ListQueryRequest) (*ListClinicReportsResponse, error)
+	// 健檢中心儀表板：期間內上傳數、更正數與報告摘要
+	GetClinicDashboard(context.Context, *ListQueryRequest) (*ClinicDashboardResponse, error)
+	// 健檢中心（或平台管理者代為）新增或更新自訂檢驗代碼與 LOINC 代碼的對應
+	UpsertAnalyteMapping(context.Context, *UpsertAnalyteMappingRequest) (*AnalyteMappingResponse, error)
+	// 刪除自訂檢驗代碼對應，之後改用內建對應
+	DeleteAnalyteMapping(context.Context, *DeleteAnalyteMappingRequest) (*AnalyteMappingResponse, error)
+	// 列出健檢中心的自訂檢驗代碼對應
+	ListAnalyteMappings(context.Context, *ListAnalyteMappingsRequest) (*ListAnalyteMappingsResponse, error)
+	// 查詢內建檢驗項目字典（LOINC 代碼、標準單位與中英文名稱）
+	ListAnalyteDictionary(context.Context, *ListAnalyteDictionaryRequest) (*ListAnalyteDictionaryResponse, error)
+	// 健檢中心匯入檢驗系統的 HL7 v2 ORU^R01 訊息或 FHIR Bundle，逐份報告回報接受或拒絕
+	IngestLabResults(context.Context, *IngestLabResultsRequest) (*IngestLabResultsResponse, error)
+	// 病患查詢保險業者讀取自己報告的紀錄
+	ListAccessLog(context.Context, *ListQueryRequest) (*ListAccessLogResponse, error)
+	// 病患封鎖保險業者，封鎖期間鏈碼拒絕其授權請求
+	BlockRequester(context.Context, *BlockRequesterRequest) (*BlockRequesterResponse, error)
+	// 病患解除封鎖
+	UnblockRequester(context.Context, *UnblockRequesterRequest) (*BlockRequesterResponse, error)
+	// 病患查看封鎖中的保險業者
+	ListBlockedRequesters(context.Context, *ListQueryRequest) (*ListBlockedRequestersResponse, error)
+	// 緊急存取：病患無法核准時由 emergency 身份讀取報告，存取紀錄寫入鏈上
+	BreakGlassRead(context.Context, *BreakGlassReadRequest) (*BreakGlassReadResponse, error)
+	// 病患查看自己報告的緊急存取紀錄
+	ListEmergencyAccesses(context.Context, *ListQueryRequest) (*ListEmergencyAccessesResponse, error)
+	// 病患確認緊急存取紀錄
+	AcknowledgeEmergencyAccess(context.Context, *AcknowledgeEmergencyAccessRequest) (*AcknowledgeEmergencyAccessResponse, error)
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
@@ -271,7 +810,16 @@ type UnimplementedHealthServiceServer struct {
 func (UnimplementedHealthServiceServer) UploadReport(context.Context, *UploadReportRequest) (*UploadReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadReport not implemented")
 }
-func (UnimplementedHealthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+func (UnimplementedHealthServiceServer) AmendReport(context.Context, *AmendReportRequest) (*AmendReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendReport not implemented")
+}
+func (UnimplementedHealthServiceServer) GetReportHistory(context.Context, *GetReportHistoryRequest) (*GetReportHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportHistory not implemented")
+}
+func (UnimplementedHealthServiceServer) GetReportAuditTrail(context.Context, *GetReportAuditTrailRequest) (*GetReportAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportAuditTrail not implemented")
+}
+func (UnimplementedHealthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
 func (UnimplementedHealthServiceServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterResponse, error) {
@@ -280,318 +828,1065 @@ func (UnimplementedHealthServiceServer) RegisterUser(context.Context, *RegisterU
 func (UnimplementedHealthServiceServer) RegisterInsurer(context.Context, *RegisterInsurerRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterInsurer not implemented")
 }
-func (UnimplementedHealthServiceServer) ListMyReportMeta(context.Context, *emptypb.Empty) (*ListMyReportMetaResponse, error) {
+func (UnimplementedHealthServiceServer) ListMyReportMeta(context.Context, *ListQueryRequest) (*ListMyReportMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyReportMeta not implemented")
 }
 func (UnimplementedHealthServiceServer) ReadMyReport(context.Context, *ReadMyReportRequest) (*ReadMyReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadMyReport not implemented")
 }
-func (UnimplementedHealthServiceServer) ListMyAuthorizedTickets(context.Context, *emptypb.Empty) (*ListAuthorizedTicketsResponse, error) {
+func (UnimplementedHealthServiceServer) ListMyAuthorizedTickets(context.Context, *ListQueryRequest) (*ListAuthorizedTicketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyAuthorizedTickets not implemented")
 }
 func (UnimplementedHealthServiceServer) RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
 }
-func (UnimplementedHealthServiceServer) ListAccessRequests(context.Context, *emptypb.Empty) (*ListAccessRequestsResponse, error) {
+func (UnimplementedHealthServiceServer) RequestAccessExtension(context.Context, *RequestAccessExtensionRequest) (*RequestAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccessExtension not implemented")
+}
+func (UnimplementedHealthServiceServer) ListExpiringTickets(context.Context, *ListExpiringTicketsRequest) (*ListAuthorizedTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringTickets not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAccessRequests(context.Context, *ListQueryRequest) (*ListAccessRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
 }
-func (UnimplementedHealthServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+func (UnimplementedHealthServiceServer) ListMyAccessRequestHistory(context.Context, *ListQueryRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyAccessRequestHistory not implemented")
+}
+func (UnimplementedHealthServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedHealthServiceServer) RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAccessRequest not implemented")
+}
+func (UnimplementedHealthServiceServer) RevokeAccessTicket(context.Context, *RevokeAccessTicketRequest) (*RevokeAccessTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessTicket not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAuthorizedReports(context.Context, *ListQueryRequest) (*ListAuthorizedReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizedReports not implemented")
+}
+func (UnimplementedHealthServiceServer) ListReportMetaByPatientID(context.Context, *PatientIDRequest) (*ListReportMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportMetaByPatientID not implemented")
+}
+func (UnimplementedHealthServiceServer) ViewAuthorizedReport(context.Context, *ViewAuthorizedReportRequest) (*ViewAuthorizedReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewAuthorizedReport not implemented")
+}
+func (UnimplementedHealthServiceServer) ExportReportFHIR(context.Context, *ExportReportFHIRRequest) (*ExportReportFHIRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReportFHIR not implemented")
+}
+func (UnimplementedHealthServiceServer) EvaluateAuthorizedPredicates(context.Context, *EvaluateAuthorizedPredicatesRequest) (*EvaluateAuthorizedPredicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateAuthorizedPredicates not implemented")
+}
+func (UnimplementedHealthServiceServer) RegisterClinic(context.Context, *RegisterClinicRequest) (*RegisterClinicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClinic not implemented")
+}
+func (UnimplementedHealthServiceServer) SuspendClinic(context.Context, *SuspendClinicRequest) (*SuspendClinicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendClinic not implemented")
+}
+func (UnimplementedHealthServiceServer) GetClinic(context.Context, *GetClinicRequest) (*GetClinicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinic not implemented")
+}
+func (UnimplementedHealthServiceServer) RegisterInsurerLicense(context.Context, *RegisterInsurerLicenseRequest) (*RegisterInsurerLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInsurerLicense not implemented")
+}
+func (UnimplementedHealthServiceServer) SuspendInsurerLicense(context.Context, *SuspendInsurerLicenseRequest) (*SuspendInsurerLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendInsurerLicense not implemented")
+}
+func (UnimplementedHealthServiceServer) GetInsurerLicense(context.Context, *GetInsurerLicenseRequest) (*GetInsurerLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsurerLicense not implemented")
+}
+func (UnimplementedHealthServiceServer) ListMyAccessRequests(context.Context, *ListQueryRequest) (*ListMyAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyAccessRequests not implemented")
+}
+func (UnimplementedHealthServiceServer) GrantDelegate(context.Context, *GrantDelegateRequest) (*GrantDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDelegate not implemented")
+}
+func (UnimplementedHealthServiceServer) RevokeDelegate(context.Context, *RevokeDelegateRequest) (*RevokeDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDelegate not implemented")
+}
+func (UnimplementedHealthServiceServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedHealthServiceServer) CreateConsentPolicy(context.Context, *CreateConsentPolicyRequest) (*ConsentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConsentPolicy not implemented")
+}
+func (UnimplementedHealthServiceServer) UpdateConsentPolicy(context.Context, *UpdateConsentPolicyRequest) (*ConsentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConsentPolicy not implemented")
+}
+func (UnimplementedHealthServiceServer) RevokeConsentPolicy(context.Context, *RevokeConsentPolicyRequest) (*ConsentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConsentPolicy not implemented")
+}
+func (UnimplementedHealthServiceServer) ListConsentPolicies(context.Context, *ListQueryRequest) (*ListConsentPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsentPolicies not implemented")
+}
+func (UnimplementedHealthServiceServer) ListClinicReports(context.Context, *ListQueryRequest) (*ListClinicReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClinicReports not implemented")
+}
+func (UnimplementedHealthServiceServer) GetClinicDashboard(context.Context, *ListQueryRequest) (*ClinicDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicDashboard not implemented")
+}
+func (UnimplementedHealthServiceServer) UpsertAnalyteMapping(context.Context, *UpsertAnalyteMappingRequest) (*AnalyteMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAnalyteMapping not implemented")
+}
+func (UnimplementedHealthServiceServer) DeleteAnalyteMapping(context.Context, *DeleteAnalyteMappingRequest) (*AnalyteMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnalyteMapping not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAnalyteMappings(context.Context, *ListAnalyteMappingsRequest) (*ListAnalyteMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnalyteMappings not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAnalyteDictionary(context.Context, *ListAnalyteDictionaryRequest) (*ListAnalyteDictionaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnalyteDictionary not implemented")
+}
+func (UnimplementedHealthServiceServer) IngestLabResults(context.Context, *IngestLabResultsRequest) (*IngestLabResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngestLabResults not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAccessLog(context.Context, *ListQueryRequest) (*ListAccessLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessLog not implemented")
+}
+func (UnimplementedHealthServiceServer) BlockRequester(context.Context, *BlockRequesterRequest) (*BlockRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRequester not implemented")
+}
+func (UnimplementedHealthServiceServer) UnblockRequester(context.Context, *UnblockRequesterRequest) (*BlockRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockRequester not implemented")
+}
+func (UnimplementedHealthServiceServer) ListBlockedRequesters(context.Context, *ListQueryRequest) (*ListBlockedRequestersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedRequesters not implemented")
+}
+func (UnimplementedHealthServiceServer) BreakGlassRead(context.Context, *BreakGlassReadRequest) (*BreakGlassReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlassRead not implemented")
+}
+func (UnimplementedHealthServiceServer) ListEmergencyAccesses(context.Context, *ListQueryRequest) (*ListEmergencyAccessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyAccesses not implemented")
+}
+func (UnimplementedHealthServiceServer) AcknowledgeEmergencyAccess(context.Context, *AcknowledgeEmergencyAccessRequest) (*AcknowledgeEmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeEmergencyAccess not implemented")
+}
+func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
+
+// UnsafeHealthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthServiceServer will
+// result in compilation errors.
+type UnsafeHealthServiceServer interface {
+	mustEmbedUnimplementedHealthServiceServer()
+}
+
+func RegisterHealthServiceServer(s grpc.ServiceRegistrar, srv HealthServiceServer) {
+	s.RegisterService(&HealthService_ServiceDesc, srv)
+}
+
+func _HealthService_UploadReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).UploadReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_UploadReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).UploadReport(ctx, req.(*UploadReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_AmendReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).AmendReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_AmendReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).AmendReport(ctx, req.(*AmendReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetReportHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetReportHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetReportHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetReportHistory(ctx, req.(*GetReportHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetReportAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetReportAuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetReportAuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetReportAuditTrail(ctx, req.(*GetReportAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RegisterUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RegisterUser(ctx, req.(*RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RegisterInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterInsurerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RegisterInsurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RegisterInsurer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RegisterInsurer(ctx, req.(*RegisterInsurerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListMyReportMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListMyReportMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListMyReportMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListMyReportMeta(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ReadMyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ReadMyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ReadMyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ReadMyReport(ctx, req.(*ReadMyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListMyAuthorizedTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListMyAuthorizedTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListMyAuthorizedTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListMyAuthorizedTickets(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RequestAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RequestAccessExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RequestAccessExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RequestAccessExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RequestAccessExtension(ctx, req.(*RequestAccessExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListExpiringTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListExpiringTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListExpiringTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListExpiringTickets(ctx, req.(*ListExpiringTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListAccessRequests(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListMyAccessRequestHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListMyAccessRequestHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListMyAccessRequestHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListMyAccessRequestHistory(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RejectAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RejectAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RejectAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RejectAccessRequest(ctx, req.(*RejectAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RevokeAccessTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RevokeAccessTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RevokeAccessTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RevokeAccessTicket(ctx, req.(*RevokeAccessTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListAuthorizedReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListAuthorizedReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListAuthorizedReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListAuthorizedReports(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListReportMetaByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListReportMetaByPatientID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListReportMetaByPatientID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListReportMetaByPatientID(ctx, req.(*PatientIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ViewAuthorizedReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewAuthorizedReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ViewAuthorizedReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ViewAuthorizedReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ViewAuthorizedReport(ctx, req.(*ViewAuthorizedReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ExportReportFHIR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReportFHIRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ExportReportFHIR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ExportReportFHIR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ExportReportFHIR(ctx, req.(*ExportReportFHIRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_EvaluateAuthorizedPredicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateAuthorizedPredicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).EvaluateAuthorizedPredicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_EvaluateAuthorizedPredicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).EvaluateAuthorizedPredicates(ctx, req.(*EvaluateAuthorizedPredicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RegisterClinic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClinicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RegisterClinic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RegisterClinic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RegisterClinic(ctx, req.(*RegisterClinicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_SuspendClinic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendClinicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).SuspendClinic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_SuspendClinic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).SuspendClinic(ctx, req.(*SuspendClinicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetClinic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClinicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetClinic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetClinic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetClinic(ctx, req.(*GetClinicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_RegisterInsurerLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterInsurerLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RegisterInsurerLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RegisterInsurerLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RegisterInsurerLicense(ctx, req.(*RegisterInsurerLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_SuspendInsurerLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendInsurerLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).SuspendInsurerLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_SuspendInsurerLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).SuspendInsurerLicense(ctx, req.(*SuspendInsurerLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetInsurerLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInsurerLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetInsurerLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetInsurerLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetInsurerLicense(ctx, req.(*GetInsurerLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectAccessRequest not implemented")
+
+func _HealthService_ListMyAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListMyAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListMyAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListMyAccessRequests(ctx, req.(*ListQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) ListAuthorizedReports(context.Context, *emptypb.Empty) (*ListAuthorizedReportsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizedReports not implemented")
+
+func _HealthService_GrantDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GrantDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GrantDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GrantDelegate(ctx, req.(*GrantDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) ListReportMetaByPatientID(context.Context, *PatientIDRequest) (*ListReportMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReportMetaByPatientID not implemented")
+
+func _HealthService_RevokeDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RevokeDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RevokeDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RevokeDelegate(ctx, req.(*RevokeDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) ViewAuthorizedReport(context.Context, *ViewAuthorizedReportRequest) (*ViewAuthorizedReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ViewAuthorizedReport not implemented")
+
+func _HealthService_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) ListMyAccessRequests(context.Context, *emptypb.Empty) (*ListMyAccessRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMyAccessRequests not implemented")
+
+func _HealthService_CreateConsentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConsentPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).CreateConsentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_CreateConsentPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).CreateConsentPolicy(ctx, req.(*CreateConsentPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
 
-// UnsafeHealthServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to HealthServiceServer will
-// result in compilation errors.
-type UnsafeHealthServiceServer interface {
-	mustEmbedUnimplementedHealthServiceServer()
+func _HealthService_UpdateConsentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConsentPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).UpdateConsentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_UpdateConsentPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).UpdateConsentPolicy(ctx, req.(*UpdateConsentPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterHealthServiceServer(s grpc.ServiceRegistrar, srv HealthServiceServer) {
-	s.RegisterService(&HealthService_ServiceDesc, srv)
+func _HealthService_RevokeConsentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConsentPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).RevokeConsentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_RevokeConsentPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).RevokeConsentPolicy(ctx, req.(*RevokeConsentPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_UploadReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadReportRequest)
+func _HealthService_ListConsentPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).UploadReport(ctx, in)
+		return srv.(HealthServiceServer).ListConsentPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_UploadReport_FullMethodName,
+		FullMethod: HealthService_ListConsentPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).UploadReport(ctx, req.(*UploadReportRequest))
+		return srv.(HealthServiceServer).ListConsentPolicies(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _HealthService_ListClinicReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).Login(ctx, in)
+		return srv.(HealthServiceServer).ListClinicReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_Login_FullMethodName,
+		FullMethod: HealthService_ListClinicReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(HealthServiceServer).ListClinicReports(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterUserRequest)
+func _HealthService_GetClinicDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).RegisterUser(ctx, in)
+		return srv.(HealthServiceServer).GetClinicDashboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_RegisterUser_FullMethodName,
+		FullMethod: HealthService_GetClinicDashboard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).RegisterUser(ctx, req.(*RegisterUserRequest))
+		return srv.(HealthServiceServer).GetClinicDashboard(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_RegisterInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterInsurerRequest)
+func _HealthService_UpsertAnalyteMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAnalyteMappingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).RegisterInsurer(ctx, in)
+		return srv.(HealthServiceServer).UpsertAnalyteMapping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_RegisterInsurer_FullMethodName,
+		FullMethod: HealthService_UpsertAnalyteMapping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).RegisterInsurer(ctx, req.(*RegisterInsurerRequest))
+		return srv.(HealthServiceServer).UpsertAnalyteMapping(ctx, req.(*UpsertAnalyteMappingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListMyReportMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _HealthService_DeleteAnalyteMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnalyteMappingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListMyReportMeta(ctx, in)
+		return srv.(HealthServiceServer).DeleteAnalyteMapping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListMyReportMeta_FullMethodName,
+		FullMethod: HealthService_DeleteAnalyteMapping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListMyReportMeta(ctx, req.(*emptypb.Empty))
+		return srv.(HealthServiceServer).DeleteAnalyteMapping(ctx, req.(*DeleteAnalyteMappingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ReadMyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadMyReportRequest)
+func _HealthService_ListAnalyteMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnalyteMappingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ReadMyReport(ctx, in)
+		return srv.(HealthServiceServer).ListAnalyteMappings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ReadMyReport_FullMethodName,
+		FullMethod: HealthService_ListAnalyteMappings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ReadMyReport(ctx, req.(*ReadMyReportRequest))
+		return srv.(HealthServiceServer).ListAnalyteMappings(ctx, req.(*ListAnalyteMappingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListMyAuthorizedTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _HealthService_ListAnalyteDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnalyteDictionaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListMyAuthorizedTickets(ctx, in)
+		return srv.(HealthServiceServer).ListAnalyteDictionary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListMyAuthorizedTickets_FullMethodName,
+		FullMethod: HealthService_ListAnalyteDictionary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListMyAuthorizedTickets(ctx, req.(*emptypb.Empty))
+		return srv.(HealthServiceServer).ListAnalyteDictionary(ctx, req.(*ListAnalyteDictionaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestAccessRequest)
+func _HealthService_IngestLabResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestLabResultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).RequestAccess(ctx, in)
+		return srv.(HealthServiceServer).IngestLabResults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_RequestAccess_FullMethodName,
+		FullMethod: HealthService_IngestLabResults_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+		return srv.(HealthServiceServer).IngestLabResults(ctx, req.(*IngestLabResultsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _HealthService_ListAccessLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListAccessRequests(ctx, in)
+		return srv.(HealthServiceServer).ListAccessLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListAccessRequests_FullMethodName,
+		FullMethod: HealthService_ListAccessLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListAccessRequests(ctx, req.(*emptypb.Empty))
+		return srv.(HealthServiceServer).ListAccessLog(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveAccessRequestRequest)
+func _HealthService_BlockRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequesterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ApproveAccessRequest(ctx, in)
+		return srv.(HealthServiceServer).BlockRequester(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ApproveAccessRequest_FullMethodName,
+		FullMethod: HealthService_BlockRequester_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+		return srv.(HealthServiceServer).BlockRequester(ctx, req.(*BlockRequesterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_RejectAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RejectAccessRequestRequest)
+func _HealthService_UnblockRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRequesterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).RejectAccessRequest(ctx, in)
+		return srv.(HealthServiceServer).UnblockRequester(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_RejectAccessRequest_FullMethodName,
+		FullMethod: HealthService_UnblockRequester_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).RejectAccessRequest(ctx, req.(*RejectAccessRequestRequest))
+		return srv.(HealthServiceServer).UnblockRequester(ctx, req.(*UnblockRequesterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListAuthorizedReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _HealthService_ListBlockedRequesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListAuthorizedReports(ctx, in)
+		return srv.(HealthServiceServer).ListBlockedRequesters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListAuthorizedReports_FullMethodName,
+		FullMethod: HealthService_ListBlockedRequesters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListAuthorizedReports(ctx, req.(*emptypb.Empty))
+		return srv.(HealthServiceServer).ListBlockedRequesters(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListReportMetaByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PatientIDRequest)
+func _HealthService_BreakGlassRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakGlassReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListReportMetaByPatientID(ctx, in)
+		return srv.(HealthServiceServer).BreakGlassRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListReportMetaByPatientID_FullMethodName,
+		FullMethod: HealthService_BreakGlassRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListReportMetaByPatientID(ctx, req.(*PatientIDRequest))
+		return srv.(HealthServiceServer).BreakGlassRead(ctx, req.(*BreakGlassReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ViewAuthorizedReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ViewAuthorizedReportRequest)
+func _HealthService_ListEmergencyAccesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ViewAuthorizedReport(ctx, in)
+		return srv.(HealthServiceServer).ListEmergencyAccesses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ViewAuthorizedReport_FullMethodName,
+		FullMethod: HealthService_ListEmergencyAccesses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ViewAuthorizedReport(ctx, req.(*ViewAuthorizedReportRequest))
+		return srv.(HealthServiceServer).ListEmergencyAccesses(ctx, req.(*ListQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthService_ListMyAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _HealthService_AcknowledgeEmergencyAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeEmergencyAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServiceServer).ListMyAccessRequests(ctx, in)
+		return srv.(HealthServiceServer).AcknowledgeEmergencyAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthService_ListMyAccessRequests_FullMethodName,
+		FullMethod: HealthService_AcknowledgeEmergencyAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServiceServer).ListMyAccessRequests(ctx, req.(*emptypb.Empty))
+		return srv.(HealthServiceServer).AcknowledgeEmergencyAccess(ctx, req.(*AcknowledgeEmergencyAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -607,6 +1902,18 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadReport",
 			Handler:    _HealthService_UploadReport_Handler,
 		},
+		{
+			MethodName: "AmendReport",
+			Handler:    _HealthService_AmendReport_Handler,
+		},
+		{
+			MethodName: "GetReportHistory",
+			Handler:    _HealthService_GetReportHistory_Handler,
+		},
+		{
+			MethodName: "GetReportAuditTrail",
+			Handler:    _HealthService_GetReportAuditTrail_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _HealthService_Login_Handler,
@@ -635,10 +1942,22 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestAccess",
 			Handler:    _HealthService_RequestAccess_Handler,
 		},
+		{
+			MethodName: "RequestAccessExtension",
+			Handler:    _HealthService_RequestAccessExtension_Handler,
+		},
+		{
+			MethodName: "ListExpiringTickets",
+			Handler:    _HealthService_ListExpiringTickets_Handler,
+		},
 		{
 			MethodName: "ListAccessRequests",
 			Handler:    _HealthService_ListAccessRequests_Handler,
 		},
+		{
+			MethodName: "ListMyAccessRequestHistory",
+			Handler:    _HealthService_ListMyAccessRequestHistory_Handler,
+		},
 		{
 			MethodName: "ApproveAccessRequest",
 			Handler:    _HealthService_ApproveAccessRequest_Handler,
@@ -647,6 +1966,10 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectAccessRequest",
 			Handler:    _HealthService_RejectAccessRequest_Handler,
 		},
+		{
+			MethodName: "RevokeAccessTicket",
+			Handler:    _HealthService_RevokeAccessTicket_Handler,
+		},
 		{
 			MethodName: "ListAuthorizedReports",
 			Handler:    _HealthService_ListAuthorizedReports_Handler,
@@ -659,10 +1982,126 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ViewAuthorizedReport",
 			Handler:    _HealthService_ViewAuthorizedReport_Handler,
 		},
+		{
+			MethodName: "ExportReportFHIR",
+			Handler:    _HealthService_ExportReportFHIR_Handler,
+		},
+		{
+			MethodName: "EvaluateAuthorizedPredicates",
+			Handler:    _HealthService_EvaluateAuthorizedPredicates_Handler,
+		},
+		{
+			MethodName: "RegisterClinic",
+			Handler:    _HealthService_RegisterClinic_Handler,
+		},
+		{
+			MethodName: "SuspendClinic",
+			Handler:    _HealthService_SuspendClinic_Handler,
+		},
+		{
+			MethodName: "GetClinic",
+			Handler:    _HealthService_GetClinic_Handler,
+		},
+		{
+			MethodName: "RegisterInsurerLicense",
+			Handler:    _HealthService_RegisterInsurerLicense_Handler,
+		},
+		{
+			MethodName: "SuspendInsurerLicense",
+			Handler:    _HealthService_SuspendInsurerLicense_Handler,
+		},
+		{
+			MethodName: "GetInsurerLicense",
+			Handler:    _HealthService_GetInsurerLicense_Handler,
+		},
 		{
 			MethodName: "ListMyAccessRequests",
 			Handler:    _HealthService_ListMyAccessRequests_Handler,
 		},
+		{
+			MethodName: "GrantDelegate",
+			Handler:    _HealthService_GrantDelegate_Handler,
+		},
+		{
+			MethodName: "RevokeDelegate",
+			Handler:    _HealthService_RevokeDelegate_Handler,
+		},
+		{
+			MethodName: "ListDelegations",
+			Handler:    _HealthService_ListDelegations_Handler,
+		},
+		{
+			MethodName: "CreateConsentPolicy",
+			Handler:    _HealthService_CreateConsentPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateConsentPolicy",
+			Handler:    _HealthService_UpdateConsentPolicy_Handler,
+		},
+		{
+			MethodName: "RevokeConsentPolicy",
+			Handler:    _HealthService_RevokeConsentPolicy_Handler,
+		},
+		{
+			MethodName: "ListConsentPolicies",
+			Handler:    _HealthService_ListConsentPolicies_Handler,
+		},
+		{
+			MethodName: "ListClinicReports",
+			Handler:    _HealthService_ListClinicReports_Handler,
+		},
+		{
+			MethodName: "GetClinicDashboard",
+			Handler:    _HealthService_GetClinicDashboard_Handler,
+		},
+		{
+			MethodName: "UpsertAnalyteMapping",
+			Handler:    _HealthService_UpsertAnalyteMapping_Handler,
+		},
+		{
+			MethodName: "DeleteAnalyteMapping",
+			Handler:    _HealthService_DeleteAnalyteMapping_Handler,
+		},
+		{
+			MethodName: "ListAnalyteMappings",
+			Handler:    _HealthService_ListAnalyteMappings_Handler,
+		},
+		{
+			MethodName: "ListAnalyteDictionary",
+			Handler:    _HealthService_ListAnalyteDictionary_Handler,
+		},
+		{
+			MethodName: "IngestLabResults",
+			Handler:    _HealthService_IngestLabResults_Handler,
+		},
+		{
+			MethodName: "ListAccessLog",
+			Handler:    _HealthService_ListAccessLog_Handler,
+		},
+		{
+			MethodName: "BlockRequester",
+			Handler:    _HealthService_BlockRequester_Handler,
+		},
+		{
+			MethodName: "UnblockRequester",
+			Handler:    _HealthService_UnblockRequester_Handler,
+		},
+		{
+			MethodName: "ListBlockedRequesters",
+			Handler:    _HealthService_ListBlockedRequesters_Handler,
+		},
+		{
+			MethodName: "BreakGlassRead",
+			Handler:    _HealthService_BreakGlassRead_Handler,
+		},
+		{
+			MethodName: "ListEmergencyAccesses",
+			Handler:    _HealthService_ListEmergencyAccesses_Handler,
+		},
+		{
+			MethodName: "AcknowledgeEmergencyAccess",
+			Handler:    _HealthService_AcknowledgeEmergencyAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/data.proto",
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 對應鏈碼 ReadReceipt 結構
+type rawReadReceipt struct {
+	ReceiptID   string   `json:"receiptId"`
+	ReportID    string   `json:"reportId"`
+	PatientHash string   `json:"patientHash"`
+	ReaderHash  string   `json:"readerHash"`
+	Fields      []string `json:"fields"`
+	Version     int32    `json:"version"`
+	ReadAt      int64    `json:"readAt"`
+}
+
+// HandleListAccessLog 處理病患查詢保險業者讀取其報告的紀錄，可依 insurer_id 與時間區間篩選
+func HandleListAccessLog(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListAccessLogResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListAccessLog", listQueryArgs(req)...)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢讀取紀錄失敗")
+	}
+
+	var raws []rawReadReceipt
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	// 公司名稱取自鏈上保險業者登錄資料
+	companies := map[string]string{}
+	entries := []*pb.AccessLogEntry{}
+	for _, r := range raws {
+		company, ok := companies[r.ReaderHash]
+		if !ok {
+			if b, err := contract.EvaluateTransaction("GetInsurer", r.ReaderHash); err == nil {
+				var insurer rawInsurer
+				if json.Unmarshal(b, &insurer) == nil {
+					company = insurer.LegalName
+				}
+			} else {
+				log.Printf("[Warning] 無法取得保險業者登錄資料: %v", err)
+			}
+			companies[r.ReaderHash] = company
+		}
+		entries = append(entries, &pb.AccessLogEntry{
+			ReceiptId:   r.ReceiptID,
+			ReportId:    r.ReportID,
+			ReaderHash:  r.ReaderHash,
+			CompanyName: company,
+			ReadAt:      r.ReadAt,
+			Fields:      r.Fields,
+			Version:     r.Version,
+		})
+	}
+
+	return &pb.ListAccessLogResponse{
+		Success:      true,
+		Entries:      entries,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
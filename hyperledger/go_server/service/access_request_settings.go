@@ -0,0 +1,77 @@
+package service
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	fc "go_server/fabric"
+	wl "go_server/wallet"
+)
+
+// AccessRequestSettings 為啟動時寫入鏈上的授權請求設定，零值代表沿用鏈上目前的設定
+type AccessRequestSettings struct {
+	ResponseWindow time.Duration // 病患回應授權請求的期限
+	MaxPending     int           // 同一保險業者對同一病患可同時待處理的請求數
+	RejectCooldown time.Duration // 被拒絕後不可再次申請的冷卻期間
+}
+
+// ApplyAccessRequestSettings 以 system 身份將授權請求設定寫入鏈上：
+// ResponseWindow 大於 0 時更新回應期限；MaxPending 與 RejectCooldown 皆大於 0 時更新待處理請求上限與冷卻期間
+func ApplyAccessRequestSettings(wallet wl.WalletInterface, builder fc.GWBuilder, systemID string, settings AccessRequestSettings) {
+	if settings.ResponseWindow <= 0 && (settings.MaxPending <= 0 || settings.RejectCooldown <= 0) {
+		return
+	}
+	entry, ok := wallet.Get(systemID)
+	if !ok {
+		log.Printf("[Warning] 錢包中沒有 system 身份 %s，不更新鏈上授權請求設定", systemID)
+		return
+	}
+
+	if settings.ResponseWindow > 0 {
+		if err := setResponseWindow(entry, builder, settings.ResponseWindow); err != nil {
+			log.Printf("[Warning] 更新授權請求回應期限失敗: %v", err)
+		} else {
+			log.Printf("[Info] 授權請求回應期限設定為 %s", settings.ResponseWindow)
+		}
+	}
+	if settings.MaxPending > 0 && settings.RejectCooldown > 0 {
+		if err := setRequestLimits(entry, builder, settings.MaxPending, settings.RejectCooldown); err != nil {
+			log.Printf("[Warning] 更新授權請求限制失敗: %v", err)
+		} else {
+			log.Printf("[Info] 每組保險業者與病患最多 %d 筆待處理請求，拒絕後冷卻 %s", settings.MaxPending, settings.RejectCooldown)
+		}
+	}
+}
+
+func setResponseWindow(entry *wl.Entry, builder fc.GWBuilder, window time.Duration) error {
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("SetRequestResponseWindow", strconv.FormatInt(int64(window.Seconds()), 10))
+	if err != nil {
+		fc.PrintGatewayError(err)
+	}
+	return err
+}
+
+func setRequestLimits(entry *wl.Entry, builder fc.GWBuilder, maxPending int, cooldown time.Duration) error {
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction(
+		"SetRequestLimits",
+		strconv.Itoa(maxPending),
+		strconv.FormatInt(int64(cooldown.Seconds()), 10),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+	}
+	return err
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go_server/analyte"
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// entryClinicID 從錢包憑證的 role 與 clinicId 屬性取得健檢中心ID
+func entryClinicID(entry *wl.Entry) (string, error) {
+	attrs, err := fc.CertAttributes(entry.ID.Credentials())
+	if err != nil {
+		return "", err
+	}
+	if attrs["role"] != "clinic" || attrs["clinicId"] == "" {
+		return "", fmt.Errorf("not a clinic identity")
+	}
+	return attrs["clinicId"], nil
+}
+
+// analyteClinicID 決定要維護哪個健檢中心的對應表：健檢中心只能維護自己的，平台管理者需指定 clinic_id
+func analyteClinicID(ctx context.Context, wallet wl.WalletInterface, requested string) (string, error) {
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if ok, err := database.IsAdminExists(userID); err == nil && ok {
+		if requested == "" {
+			return "", status.Error(codes.InvalidArgument, "必須提供健檢中心ID")
+		}
+		return requested, nil
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return "", status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+	clinicID, err := entryClinicID(entry)
+	if err != nil {
+		return "", status.Error(codes.PermissionDenied, "只有健檢中心或管理者可以維護檢驗代碼對應")
+	}
+	if requested != "" && requested != clinicID {
+		return "", status.Error(codes.PermissionDenied, "不可維護其他健檢中心的檢驗代碼對應")
+	}
+	return clinicID, nil
+}
+
+func toPbAnalyteMapping(m database.AnalyteMapping) *pb.AnalyteMapping {
+	return &pb.AnalyteMapping{
+		LocalCode: m.LocalCode,
+		Loinc:     m.LOINC,
+		Unit:      m.Unit,
+		NameZh:    m.NameZH,
+		NameEn:    m.NameEN,
+		LocalUnit: m.LocalUnit,
+		Factor:    m.Factor,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// clinicAnalyteTable 讀取健檢中心的檢驗代碼對應表
+func clinicAnalyteTable(clinicID string) (*analyte.Table, error) {
+	rows, err := database.ListAnalyteMappings(clinicID)
+	if err != nil {
+		log.Printf("[Error] 讀取檢驗代碼對應失敗: %v", err)
+		return nil, status.Error(codes.Internal, "讀取檢驗代碼對應失敗")
+	}
+	mappings := make([]analyte.Mapping, 0, len(rows))
+	for _, r := range rows {
+		mappings = append(mappings, analyte.Mapping{
+			LocalCode: r.LocalCode,
+			LOINC:     r.LOINC,
+			Unit:      r.Unit,
+			NameZH:    r.NameZH,
+			NameEN:    r.NameEN,
+			LocalUnit: r.LocalUnit,
+			Factor:    r.Factor,
+		})
+	}
+	return analyte.NewTable(mappings), nil
+}
+
+// normalizeLabResults 依上傳者所屬健檢中心的對應表，將報告內容改為以 LOINC 代碼為鍵並換算為標準單位
+func normalizeLabResults(entry *wl.Entry, resultJSON string) (string, []string, error) {
+	clinicID, err := entryClinicID(entry)
+	if err != nil {
+		return "", nil, status.Error(codes.PermissionDenied, "只有健檢中心可以上傳報告")
+	}
+	table, err := clinicAnalyteTable(clinicID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	normalized, unmapped, violations, err := table.Normalize(resultJSON)
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(violations) > 0 {
+		fields := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+		for _, v := range violations {
+			fields = append(fields, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+		}
+		return "", nil, labResultError(fields)
+	}
+	if len(unmapped) > 0 {
+		log.Printf("[Warning] 健檢中心 %s 有 %d 個檢驗項目對應不到 LOINC 代碼: %v", clinicID, len(unmapped), unmapped)
+	}
+	return normalized, unmapped, nil
+}
+
+// HandleUpsertAnalyteMapping 處理新增或更新檢驗代碼對應
+func HandleUpsertAnalyteMapping(
+	ctx context.Context,
+	req *pb.UpsertAnalyteMappingRequest,
+	wallet wl.WalletInterface) (*pb.AnalyteMappingResponse, error) {
+
+	clinicID, err := analyteClinicID(ctx, wallet, req.ClinicId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Mapping == nil {
+		return nil, status.Error(codes.InvalidArgument, "必須提供對應內容")
+	}
+
+	m, err := analyte.ValidateMapping(analyte.Mapping{
+		LocalCode: req.Mapping.LocalCode,
+		LOINC:     req.Mapping.Loinc,
+		Unit:      req.Mapping.Unit,
+		NameZH:    req.Mapping.NameZh,
+		NameEN:    req.Mapping.NameEn,
+		LocalUnit: req.Mapping.LocalUnit,
+		Factor:    req.Mapping.Factor,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	row := database.AnalyteMapping{
+		ClinicID:  clinicID,
+		LocalCode: m.LocalCode,
+		LOINC:     m.LOINC,
+		Unit:      m.Unit,
+		NameZH:    m.NameZH,
+		NameEN:    m.NameEN,
+		LocalUnit: m.LocalUnit,
+		Factor:    m.Factor,
+	}
+	if err := database.UpsertAnalyteMapping(row); err != nil {
+		log.Printf("[Error] 寫入檢驗代碼對應失敗: %v", err)
+		return nil, status.Error(codes.Internal, "寫入檢驗代碼對應失敗")
+	}
+
+	return &pb.AnalyteMappingResponse{
+		Success: true,
+		Message: "檢驗代碼對應已更新",
+		Mapping: toPbAnalyteMapping(row),
+	}, nil
+}
+
+// HandleDeleteAnalyteMapping 處理刪除檢驗代碼對應
+func HandleDeleteAnalyteMapping(
+	ctx context.Context,
+	req *pb.DeleteAnalyteMappingRequest,
+	wallet wl.WalletInterface) (*pb.AnalyteMappingResponse, error) {
+
+	clinicID, err := analyteClinicID(ctx, wallet, req.ClinicId)
+	if err != nil {
+		return nil, err
+	}
+	if req.LocalCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供自訂代碼")
+	}
+
+	deleted, err := database.DeleteAnalyteMapping(clinicID, req.LocalCode)
+	if err != nil {
+		log.Printf("[Error] 刪除檢驗代碼對應失敗: %v", err)
+		return nil, status.Error(codes.Internal, "刪除檢驗代碼對應失敗")
+	}
+	if !deleted {
+		return nil, status.Error(codes.NotFound, "找不到此自訂代碼的對應")
+	}
+	return &pb.AnalyteMappingResponse{
+		Success: true,
+		Message: "檢驗代碼對應已刪除",
+	}, nil
+}
+
+// HandleListAnalyteMappings 處理查詢健檢中心的檢驗代碼對應
+func HandleListAnalyteMappings(
+	ctx context.Context,
+	req *pb.ListAnalyteMappingsRequest,
+	wallet wl.WalletInterface) (*pb.ListAnalyteMappingsResponse, error) {
+
+	clinicID, err := analyteClinicID(ctx, wallet, req.ClinicId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := database.ListAnalyteMappings(clinicID)
+	if err != nil {
+		log.Printf("[Error] 讀取檢驗代碼對應失敗: %v", err)
+		return nil, status.Error(codes.Internal, "讀取檢驗代碼對應失敗")
+	}
+
+	mappings := []*pb.AnalyteMapping{}
+	for _, r := range rows {
+		mappings = append(mappings, toPbAnalyteMapping(r))
+	}
+	return &pb.ListAnalyteMappingsResponse{
+		Success:  true,
+		ClinicId: clinicID,
+		Mappings: mappings,
+	}, nil
+}
+
+// HandleListAnalyteDictionary 處理查詢內建檢驗項目字典
+func HandleListAnalyteDictionary(
+	ctx context.Context,
+	req *pb.ListAnalyteDictionaryRequest) (*pb.ListAnalyteDictionaryResponse, error) {
+
+	if _, err := ut.ExtractUserIDFromContext(ctx); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	analytes := []*pb.Analyte{}
+	for _, a := range analyte.Dictionary() {
+		analytes = append(analytes, &pb.Analyte{
+			Loinc:  a.LOINC,
+			Unit:   a.Unit,
+			NameZh: a.NameZH,
+			NameEn: a.NameEN,
+			Codes:  a.Codes,
+		})
+	}
+	return &pb.ListAnalyteDictionaryResponse{
+		Success:  true,
+		Analytes: analytes,
+	}, nil
+}
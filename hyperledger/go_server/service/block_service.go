@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 對應鏈碼 RequesterBlock 結構
+type rawRequesterBlock struct {
+	PatientHash   string `json:"patientHash"`
+	RequesterHash string `json:"requesterHash"`
+	Reason        string `json:"reason"`
+	BlockedAt     int64  `json:"blockedAt"`
+}
+
+// requestAccessError 將鏈碼拒絕授權請求的原因轉為對應的 gRPC 狀態
+func requestAccessError(err error, fallback string) error {
+	msg := fc.ChaincodeMessage(err)
+	switch {
+	case strings.Contains(msg, "blocked by patient"):
+		return status.Error(codes.PermissionDenied, "病患已封鎖您的授權請求")
+	case strings.Contains(msg, "too many pending requests"):
+		return status.Error(codes.ResourceExhausted, "對此病患待處理的授權請求已達上限")
+	case strings.Contains(msg, "cooldown"):
+		return status.Error(codes.FailedPrecondition, "授權請求剛被拒絕，冷卻期間內不可再次申請")
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// HandleBlockRequester 處理病患（或代理人）封鎖保險業者
+func HandleBlockRequester(
+	ctx context.Context,
+	req *pb.BlockRequesterRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.BlockRequesterResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	insurerHash := req.InsurerHash
+	if req.InsurerId != "" {
+		if ok, err := database.IsInsurerExists(req.InsurerId); err != nil || !ok {
+			return nil, status.Error(codes.NotFound, "保險業者不存在")
+		}
+		insurerHash = database.ResolveInsurerHash(req.InsurerId)
+	}
+	if insurerHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者帳號或假名")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	if _, err := contract.SubmitTransaction("BlockRequester", insurerHash, req.Reason, actingPatientHash(req.PatientId)); err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "封鎖保險業者失敗")
+	}
+
+	return &pb.BlockRequesterResponse{
+		Success: true,
+		Message: "已封鎖保險業者",
+	}, nil
+}
+
+// HandleUnblockRequester 處理病患（或代理人）解除封鎖
+func HandleUnblockRequester(
+	ctx context.Context,
+	req *pb.UnblockRequesterRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.BlockRequesterResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.InsurerHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者假名")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	if _, err := contract.SubmitTransaction("UnblockRequester", req.InsurerHash, actingPatientHash(req.PatientId)); err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "解除封鎖失敗")
+	}
+
+	return &pb.BlockRequesterResponse{
+		Success: true,
+		Message: "已解除封鎖",
+	}, nil
+}
+
+// HandleListBlockedRequesters 處理病患（或代理人）查詢封鎖中的保險業者，只使用 patient_id
+func HandleListBlockedRequesters(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListBlockedRequestersResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListBlockedRequesters", actingPatientHash(req.PatientId))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢封鎖名單失敗")
+	}
+
+	var raws []rawRequesterBlock
+	if err := json.Unmarshal(result, &raws); err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	// 公司名稱取自鏈上保險業者登錄資料
+	blocked := []*pb.BlockedRequester{}
+	for _, r := range raws {
+		var company string
+		if b, err := contract.EvaluateTransaction("GetInsurer", r.RequesterHash); err == nil {
+			var insurer rawInsurer
+			if json.Unmarshal(b, &insurer) == nil {
+				company = insurer.LegalName
+			}
+		} else {
+			log.Printf("[Warning] 無法取得保險業者登錄資料: %v", err)
+		}
+		blocked = append(blocked, &pb.BlockedRequester{
+			InsurerHash: r.RequesterHash,
+			CompanyName: company,
+			Reason:      r.Reason,
+			BlockedAt:   r.BlockedAt,
+		})
+	}
+
+	return &pb.ListBlockedRequestersResponse{
+		Success: true,
+		Blocked: blocked,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 對應鏈碼 ClinicReport 結構
+type rawClinicReport struct {
+	ReportID            string `json:"reportId"`
+	PatientHash         string `json:"patientHash"`
+	CreatedAt           int64  `json:"createdAt"`
+	Version             int32  `json:"version"`
+	ResultHash          string `json:"resultHash"`
+	AmendedAt           int64  `json:"amendedAt"`
+	AmendReason         string `json:"amendReason"`
+	AccessRequestCount  int32  `json:"accessRequestCount"`
+	PendingRequestCount int32  `json:"pendingRequestCount"`
+}
+
+// 對應鏈碼 ClinicUploadStats 結構
+type rawClinicUploadStats struct {
+	ClinicID     string `json:"clinicId"`
+	UploadCount  int32  `json:"uploadCount"`
+	AmendedCount int32  `json:"amendedCount"`
+}
+
+// clinicContract 取得健檢中心的合約，權限由鏈碼依憑證的 role 與 clinicId 檢查
+func clinicContract(
+	ctx context.Context,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*client.Contract, *client.Gateway, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	return contract, gw, nil
+}
+
+// listClinicReports 查詢一頁報告摘要，並從資料庫補上病患姓名
+func listClinicReports(contract *client.Contract, req *pb.ListQueryRequest) ([]*pb.ClinicReport, *rawPage, error) {
+	result, err := contract.EvaluateTransaction("ListReportsByClinic", pageArgs(req.PageSize, req.Bookmark, rawListFilter{
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+	})...)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, nil, status.Error(codes.Internal, "查詢上傳報告失敗")
+	}
+
+	var raws []rawClinicReport
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	reports := []*pb.ClinicReport{}
+	for _, r := range raws {
+		var patientName string
+		if user, err := database.GetUserByHash(r.PatientHash); err == nil {
+			patientName = user.Name
+		} else {
+			log.Printf("[Warning] 無法獲取病患資訊: %v", err)
+		}
+		reports = append(reports, &pb.ClinicReport{
+			ReportId:            r.ReportID,
+			PatientHash:         r.PatientHash,
+			PatientName:         patientName,
+			CreatedAt:           r.CreatedAt,
+			Version:             r.Version,
+			ResultHash:          r.ResultHash,
+			AmendedAt:           r.AmendedAt,
+			AmendReason:         r.AmendReason,
+			AccessRequestCount:  r.AccessRequestCount,
+			PendingRequestCount: r.PendingRequestCount,
+		})
+	}
+	return reports, page, nil
+}
+
+// HandleListClinicReports 處理健檢中心查詢自己上傳的報告
+func HandleListClinicReports(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListClinicReportsResponse, error) {
+
+	contract, gw, err := clinicContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	reports, page, err := listClinicReports(contract, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListClinicReportsResponse{
+		Success:      true,
+		Reports:      reports,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
+
+// HandleGetClinicDashboard 處理健檢中心儀表板：期間內的上傳統計與一頁報告摘要
+func HandleGetClinicDashboard(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ClinicDashboardResponse, error) {
+
+	contract, gw, err := clinicContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	filter, _ := json.Marshal(rawListFilter{FromDate: req.FromDate, ToDate: req.ToDate})
+	result, err := contract.EvaluateTransaction("GetClinicUploadStats", string(filter))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢上傳統計失敗")
+	}
+	var stats rawClinicUploadStats
+	if err := json.Unmarshal(result, &stats); err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	reports, page, err := listClinicReports(contract, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClinicDashboardResponse{
+		Success:      true,
+		ClinicId:     stats.ClinicID,
+		UploadCount:  stats.UploadCount,
+		AmendedCount: stats.AmendedCount,
+		Reports:      reports,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
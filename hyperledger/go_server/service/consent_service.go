@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandleCreateConsentPolicy 處理病患（或代理人）建立同意政策
+func HandleCreateConsentPolicy(
+	ctx context.Context,
+	req *pb.CreateConsentPolicyRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ConsentPolicyResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.InsurerId == "" || req.ValidUntil <= req.ValidFrom {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者帳號與有效期間")
+	}
+	if ok, err := database.IsInsurerExists(req.InsurerId); err != nil || !ok {
+		return nil, status.Error(codes.NotFound, "保險業者帳號不存在")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.SubmitTransaction(
+		"CreateConsentPolicy",
+		database.ResolveInsurerHash(req.InsurerId),
+		fieldsArg(req.ClinicIds),
+		strconv.FormatInt(req.ValidFrom, 10),
+		strconv.FormatInt(req.ValidUntil, 10),
+		fieldsArg(req.Fields),
+		strconv.FormatBool(req.AutoPush),
+		actingPatientHash(req.PatientId),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "建立同意政策失敗")
+	}
+
+	return &pb.ConsentPolicyResponse{
+		Success:  true,
+		Message:  "已建立同意政策",
+		PolicyId: string(result),
+	}, nil
+}
+
+// HandleUpdateConsentPolicy 處理病患（或代理人）更新同意政策
+func HandleUpdateConsentPolicy(
+	ctx context.Context,
+	req *pb.UpdateConsentPolicyRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ConsentPolicyResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.PolicyId == "" || req.ValidUntil <= req.ValidFrom {
+		return nil, status.Error(codes.InvalidArgument, "必須提供政策ID與有效期間")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction(
+		"UpdateConsentPolicy",
+		req.PolicyId,
+		fieldsArg(req.ClinicIds),
+		strconv.FormatInt(req.ValidFrom, 10),
+		strconv.FormatInt(req.ValidUntil, 10),
+		fieldsArg(req.Fields),
+		strconv.FormatBool(req.AutoPush),
+		actingPatientHash(req.PatientId),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "更新同意政策失敗")
+	}
+
+	return &pb.ConsentPolicyResponse{
+		Success:  true,
+		Message:  "已更新同意政策",
+		PolicyId: req.PolicyId,
+	}, nil
+}
+
+// HandleRevokeConsentPolicy 處理病患（或代理人）撤銷同意政策
+func HandleRevokeConsentPolicy(
+	ctx context.Context,
+	req *pb.RevokeConsentPolicyRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ConsentPolicyResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.PolicyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供政策ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("RevokeConsentPolicy", req.PolicyId, actingPatientHash(req.PatientId))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "撤銷同意政策失敗")
+	}
+
+	return &pb.ConsentPolicyResponse{
+		Success:  true,
+		Message:  "已撤銷同意政策，已核發的授權票據需另行撤銷",
+		PolicyId: req.PolicyId,
+	}, nil
+}
+
+// 對應鏈碼的 ConsentPolicy 結構
+type rawConsentPolicy struct {
+	PolicyID    string   `json:"policyId"`
+	PatientHash string   `json:"patientHash"`
+	InsurerHash string   `json:"insurerHash"`
+	ClinicIDs   []string `json:"clinicIds"`
+	ValidFrom   int64    `json:"validFrom"`
+	ValidUntil  int64    `json:"validUntil"`
+	Fields      []string `json:"fields"`
+	AutoPush    bool     `json:"autoPush"`
+	Revoked     bool     `json:"revoked"`
+	RevokedAt   int64    `json:"revokedAt"`
+	CreatedAt   int64    `json:"createdAt"`
+	UpdatedAt   int64    `json:"updatedAt"`
+}
+
+// HandleListConsentPolicies 列出同意政策
+func HandleListConsentPolicies(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListConsentPoliciesResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListMyConsentPolicies", listQueryArgs(req)...)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢同意政策失敗")
+	}
+
+	var raws []rawConsentPolicy
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	// 公司名稱取自鏈上保險業者登錄資料
+	companies := map[string]string{}
+	var policies []*pb.ConsentPolicy
+	for _, r := range raws {
+		company, ok := companies[r.InsurerHash]
+		if !ok {
+			if b, err := contract.EvaluateTransaction("GetInsurer", r.InsurerHash); err == nil {
+				var insurer rawInsurer
+				if json.Unmarshal(b, &insurer) == nil {
+					company = insurer.LegalName
+				}
+			} else {
+				log.Printf("[Warning] 無法取得保險業者登錄資料: %v", err)
+			}
+			companies[r.InsurerHash] = company
+		}
+		policies = append(policies, &pb.ConsentPolicy{
+			PolicyId:    r.PolicyID,
+			PatientHash: r.PatientHash,
+			InsurerHash: r.InsurerHash,
+			CompanyName: company,
+			ClinicIds:   r.ClinicIDs,
+			ValidFrom:   r.ValidFrom,
+			ValidUntil:  r.ValidUntil,
+			Fields:      r.Fields,
+			AutoPush:    r.AutoPush,
+			Revoked:     r.Revoked,
+			RevokedAt:   r.RevokedAt,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+		})
+	}
+
+	return &pb.ListConsentPoliciesResponse{
+		Success:      true,
+		Policies:     policies,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
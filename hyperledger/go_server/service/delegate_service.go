@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// actingPatientHash 代理人操作時將被代理病患ID 轉為假名傳給鏈碼，本人操作傳空字串
+func actingPatientHash(patientID string) string {
+	if patientID == "" {
+		return ""
+	}
+	return database.ResolveUserHash(patientID)
+}
+
+// HandleGrantDelegate 處理病患指定代理人
+func HandleGrantDelegate(
+	ctx context.Context,
+	req *pb.GrantDelegateRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.GrantDelegateResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.DelegateId == "" || req.Scope == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供代理人帳號與代理範圍")
+	}
+	if req.DelegateId == userID {
+		return nil, status.Error(codes.InvalidArgument, "不能指定自己為代理人")
+	}
+	if ok, err := database.IsUserExists(req.DelegateId); err != nil || !ok {
+		return nil, status.Error(codes.NotFound, "代理人帳號不存在")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction(
+		"GrantDelegate",
+		database.ResolveUserHash(req.DelegateId),
+		req.Scope,
+		strconv.FormatInt(req.Expiry, 10),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "指定代理人失敗")
+	}
+
+	return &pb.GrantDelegateResponse{
+		Success: true,
+		Message: "已指定代理人",
+	}, nil
+}
+
+// HandleRevokeDelegate 處理病患撤銷代理人
+func HandleRevokeDelegate(
+	ctx context.Context,
+	req *pb.RevokeDelegateRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.RevokeDelegateResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.DelegateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供代理人帳號")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("RevokeDelegate", database.ResolveUserHash(req.DelegateId))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "撤銷代理人失敗")
+	}
+
+	return &pb.RevokeDelegateResponse{
+		Success: true,
+		Message: "已撤銷代理人",
+	}, nil
+}
+
+// 對應鏈碼的 Delegation 結構
+type rawDelegation struct {
+	PatientHash  string `json:"patientHash"`
+	DelegateHash string `json:"delegateHash"`
+	Scope        string `json:"scope"`
+	GrantedAt    int64  `json:"grantedAt"`
+	Expiry       int64  `json:"expiry"`
+	Revoked      bool   `json:"revoked"`
+	RevokedAt    int64  `json:"revokedAt"`
+}
+
+// HandleListDelegations 列出自己指定的代理人，或自己可代理的病患
+func HandleListDelegations(
+	ctx context.Context,
+	req *pb.ListDelegationsRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListDelegationsResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListMyDelegations", strconv.FormatBool(req.AsDelegate))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢代理授權失敗")
+	}
+
+	var raws []rawDelegation
+	if err := json.Unmarshal(result, &raws); err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	var delegations []*pb.Delegation
+	for _, r := range raws {
+		// 從資料庫補上雙方姓名，查不到時仍回傳紀錄
+		var patientName, delegateName string
+		if user, err := database.GetUserByHash(r.PatientHash); err == nil {
+			patientName = user.Name
+		}
+		if user, err := database.GetUserByHash(r.DelegateHash); err == nil {
+			delegateName = user.Name
+		}
+		delegations = append(delegations, &pb.Delegation{
+			PatientHash:  r.PatientHash,
+			DelegateHash: r.DelegateHash,
+			Scope:        r.Scope,
+			GrantedAt:    r.GrantedAt,
+			Expiry:       r.Expiry,
+			Revoked:      r.Revoked,
+			RevokedAt:    r.RevokedAt,
+			PatientName:  patientName,
+			DelegateName: delegateName,
+		})
+	}
+
+	return &pb.ListDelegationsResponse{
+		Success:     true,
+		Delegations: delegations,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandleBreakGlassRead 處理急診人員的緊急存取：先以 submit 寫入存取紀錄，再讀取報告內容
+func HandleBreakGlassRead(
+	ctx context.Context,
+	req *pb.BreakGlassReadRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.BreakGlassReadResponse, error) {
+
+	staffID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if ok, err := database.IsEmergencyStaffExists(staffID); err != nil || !ok {
+		return nil, status.Error(codes.PermissionDenied, "只有急診人員可以使用緊急存取")
+	}
+	if req.PatientId == "" || req.ReportId == "" || req.Justification == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供病患ID、報告ID與緊急存取理由")
+	}
+
+	entry, ok := wallet.Get(staffID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	// 1. 寫入緊急存取紀錄（交易回應會寫入區塊，因此不含報告內容）
+	log.Printf("[Audit] 緊急存取: staff=%s report=%s", staffID, req.ReportId)
+	accessID, err := contract.SubmitTransaction(
+		"BreakGlassRead",
+		database.ResolveUserHash(req.PatientId),
+		req.ReportId,
+		req.Justification,
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "寫入緊急存取紀錄失敗")
+	}
+
+	// 2. 以存取紀錄讀取報告內容
+	result, err := contract.EvaluateTransaction("ReadBreakGlassReport", string(accessID))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "讀取報告失敗")
+	}
+
+	var content rawReportContent
+	if err := json.Unmarshal(result, &content); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+
+	return &pb.BreakGlassReadResponse{
+		Success:       true,
+		AccessId:      string(accessID),
+		ResultJson:    content.ResultJSON,
+		Version:       content.Version,
+		SchemaVersion: content.SchemaVersion,
+	}, nil
+}
+
+// 對應鏈碼的 EmergencyAccess 結構
+type rawEmergencyAccess struct {
+	AccessID       string `json:"accessId"`
+	ReportID       string `json:"reportId"`
+	PatientHash    string `json:"patientHash"`
+	ActorID        string `json:"actorId"`
+	ActorMSP       string `json:"actorMsp"`
+	Justification  string `json:"justification"`
+	AccessedAt     int64  `json:"accessedAt"`
+	Acknowledged   bool   `json:"acknowledged"`
+	AcknowledgedAt int64  `json:"acknowledgedAt"`
+}
+
+// HandleListEmergencyAccesses 病患列出自己報告的緊急存取紀錄
+func HandleListEmergencyAccesses(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListEmergencyAccessesResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListEmergencyAccesses", listQueryArgs(req)...)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢緊急存取紀錄失敗")
+	}
+
+	var raws []rawEmergencyAccess
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	var accesses []*pb.EmergencyAccess
+	for _, r := range raws {
+		accesses = append(accesses, &pb.EmergencyAccess{
+			AccessId:       r.AccessID,
+			ReportId:       r.ReportID,
+			PatientHash:    r.PatientHash,
+			ActorId:        r.ActorID,
+			ActorMsp:       r.ActorMSP,
+			Justification:  r.Justification,
+			AccessedAt:     r.AccessedAt,
+			Acknowledged:   r.Acknowledged,
+			AcknowledgedAt: r.AcknowledgedAt,
+		})
+	}
+
+	return &pb.ListEmergencyAccessesResponse{
+		Success:      true,
+		Accesses:     accesses,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
+
+// HandleAcknowledgeEmergencyAccess 病患（或代理人）確認緊急存取紀錄
+func HandleAcknowledgeEmergencyAccess(
+	ctx context.Context,
+	req *pb.AcknowledgeEmergencyAccessRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.AcknowledgeEmergencyAccessResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.AccessId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供緊急存取紀錄ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("AcknowledgeEmergencyAccess", req.AccessId, actingPatientHash(req.PatientId))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "確認緊急存取紀錄失敗")
+	}
+
+	return &pb.AcknowledgeEmergencyAccessResponse{
+		Success: true,
+		Message: "已確認緊急存取紀錄",
+	}, nil
+}
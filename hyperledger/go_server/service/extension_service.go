@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandleRequestAccessExtension 處理保險業者請求延長既有票據，由病患以一般授權請求流程核准或拒絕
+func HandleRequestAccessExtension(
+	ctx context.Context,
+	req *pb.RequestAccessExtensionRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.RequestAccessResponse, error) {
+
+	requesterId, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if _, err := database.GetInsurerPassword(requesterId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "只有保險業者可以申請延長授權")
+	}
+	if req.PatientHash == "" || req.ReportId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供病患、報告ID和申請原因")
+	}
+	if req.Expiry <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "延長後的到期時間必須晚於現在")
+	}
+
+	entry, ok := wallet.Get(requesterId)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.SubmitTransaction(
+		"RequestAccessExtension",
+		req.ReportId,
+		req.PatientHash,
+		req.Reason,
+		strconv.FormatInt(req.Expiry, 10),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, requestAccessError(err, "延長授權請求失敗")
+	}
+
+	return &pb.RequestAccessResponse{
+		Success:   true,
+		Message:   "延長授權請求已送出",
+		RequestId: string(result),
+	}, nil
+}
+
+// HandleListExpiringTickets 處理保險業者查詢即將到期的票據
+func HandleListExpiringTickets(
+	ctx context.Context,
+	req *pb.ListExpiringTicketsRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListAuthorizedTicketsResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if _, err := database.GetInsurerPassword(userID); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "只有保險業者可以查詢即將到期的票據")
+	}
+	days := req.Days
+	if days <= 0 {
+		days = 7
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction(
+		"ListExpiringTickets",
+		strconv.FormatInt(int64(days), 10),
+		strconv.FormatInt(int64(req.PageSize), 10),
+		req.Bookmark,
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢即將到期的票據失敗")
+	}
+
+	var raws []rawAuthTicket
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	tickets := []*pb.AuthTicket{}
+	for _, r := range raws {
+		tickets = append(tickets, &pb.AuthTicket{
+			PatientHash:    r.PatientHash,
+			TargetHash:     r.TargetHash,
+			ReportId:       r.ReportID,
+			GrantedAt:      r.GrantedAt,
+			Expiry:         r.Expiry,
+			GrantedFields:  r.GrantedFields,
+			AccessMode:     r.AccessMode,
+			Predicates:     toPbPredicates(r.Predicates),
+			GrantedBy:      r.GrantedBy,
+			PolicyId:       r.PolicyID,
+			ExtendedAt:     r.ExtendedAt,
+			PreviousExpiry: r.PreviousExpiry,
+		})
+	}
+	return &pb.ListAuthorizedTicketsResponse{
+		Success:      true,
+		Tickets:      tickets,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	"go_server/fhir"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandleExportReportFHIR 處理以 FHIR R4 Bundle 匯出報告。
+// 病患走 ReadMyReport 的授權路徑；保險業者走 ReadAuthorizedReport 的票據路徑，會寫入讀取收據並計入讀取次數，
+// 欄位限定的票據只匯出授權的項目
+func HandleExportReportFHIR(
+	ctx context.Context,
+	req *pb.ExportReportFHIRRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ExportReportFHIRResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.ReportId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID")
+	}
+	_, insurerErr := database.GetInsurerPassword(userID)
+	isInsurer := insurerErr == nil
+	if isInsurer && req.PatientHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "保險業者必須提供病患假名")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	var (
+		content     *rawReportContent
+		receiptID   string
+		patientHash string
+	)
+	if isInsurer {
+		patientHash = req.PatientHash
+		content, receiptID, err = readAuthorizedReport(contract, req.PatientHash, req.ReportId)
+	} else {
+		patientHash = database.ResolveUserHash(userID)
+		content, err = readMyReport(contract, req.ReportId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 健檢中心名稱僅供顯示，查不到時只保留 clinicId
+	var clinicName string
+	if result, err := contract.EvaluateTransaction("GetClinic", content.ClinicID); err == nil {
+		var clinic rawClinic
+		if json.Unmarshal(result, &clinic) == nil {
+			clinicName = clinic.Name
+		}
+	} else {
+		log.Printf("[Warning] 無法取得健檢中心資訊: %v", err)
+	}
+
+	bundle, err := fhir.BuildBundle(fhir.Report{
+		ReportID:      content.ReportID,
+		ClinicID:      content.ClinicID,
+		ClinicName:    clinicName,
+		PatientHash:   patientHash,
+		Version:       content.Version,
+		SchemaVersion: content.SchemaVersion,
+		CreatedAt:     content.CreatedAt,
+		AmendedAt:     content.AmendedAt,
+		ResultJSON:    content.ResultJSON,
+	}, time.Now())
+	if err != nil {
+		log.Printf("[Error] 產生 FHIR Bundle 失敗: %v", err)
+		return nil, status.Error(codes.Internal, "產生 FHIR Bundle 失敗")
+	}
+
+	return &pb.ExportReportFHIRResponse{
+		Success:    true,
+		BundleJson: string(bundle),
+		ReceiptId:  receiptID,
+	}, nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"go_server/analyte"
+	"go_server/database"
+	fc "go_server/fabric"
+	"go_server/ingest"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 匯入時報告被拒絕的原因
+const (
+	rejectParseError      = "PARSE_ERROR"
+	rejectInvalidResults  = "INVALID_RESULTS"
+	rejectDuplicateReport = "DUPLICATE_REPORT"
+	rejectChainError      = "CHAIN_ERROR"
+)
+
+// HandleIngestLabResults 處理健檢中心匯入 HL7 v2 ORU^R01 訊息或 FHIR Bundle。
+// 每份報告各自解析、檢查、正規化並以 UploadReport 上傳，一份被拒絕不影響其他報告；
+// 回應逐份列出接受或拒絕的原因與原始訊息中的位置，HL7 輸入另附 ACK 供檢驗系統對帳
+func HandleIngestLabResults(
+	ctx context.Context,
+	req *pb.IngestLabResultsRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.IngestLabResultsResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if strings.TrimSpace(req.Payload) == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供訊息內容")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+	clinicID, err := entryClinicID(entry)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "只有健檢中心可以匯入檢驗結果")
+	}
+
+	batch, err := ingest.Parse(req.Format, req.Payload, req.IdentifierType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "訊息格式錯誤: "+err.Error())
+	}
+	table, err := clinicAnalyteTable(clinicID)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	resp := &pb.IngestLabResultsResponse{
+		Format:    batch.Format,
+		MessageId: batch.MessageID,
+		Reports:   []*pb.IngestReportResult{},
+	}
+	outcomes := make([]ingest.Outcome, 0, len(batch.Reports))
+	for _, r := range batch.Reports {
+		result := ingestReport(contract, table, r)
+		if result.Accepted {
+			resp.AcceptedCount++
+		} else {
+			resp.RejectedCount++
+		}
+		resp.Reports = append(resp.Reports, result)
+		outcomes = append(outcomes, ingest.Outcome{ReportID: r.ReportID, Accepted: result.Accepted, Message: result.Message})
+	}
+	resp.Success = resp.RejectedCount == 0
+	log.Printf("[Info] 健檢中心 %s 匯入 %s 訊息 %s: 接受 %d 份，拒絕 %d 份",
+		clinicID, batch.Format, batch.MessageID, resp.AcceptedCount, resp.RejectedCount)
+
+	if batch.Format == ingest.FormatHL7 {
+		now := time.Now()
+		resp.Hl7Ack = ingest.BuildACK(batch, outcomes, "ACK"+strconv.FormatInt(now.UnixNano(), 36), now)
+	}
+	return resp, nil
+}
+
+// ingestReport 檢查並上傳單一報告，任何錯誤都只記在該報告的結果中
+func ingestReport(contract *client.Contract, table *analyte.Table, r ingest.Report) *pb.IngestReportResult {
+	result := &pb.IngestReportResult{
+		ReportId:         r.ReportID,
+		SourceRef:        r.SourceRef,
+		ObservationCount: int32(len(r.Results)),
+		Errors:           []*pb.IngestFieldError{},
+	}
+	reject := func(reason, message string) *pb.IngestReportResult {
+		result.Reason = reason
+		result.Message = message
+		return result
+	}
+
+	if len(r.Errors) > 0 {
+		for _, e := range r.Errors {
+			result.Errors = append(result.Errors, &pb.IngestFieldError{
+				SourceRef: e.SourceRef, Field: e.Field, Description: e.Description,
+			})
+		}
+		return reject(rejectParseError, fmt.Sprintf("%d 個欄位無法轉換", len(r.Errors)))
+	}
+
+	raw, err := json.Marshal(r.Results)
+	if err != nil {
+		return reject(rejectParseError, "無法產生報告內容")
+	}
+	// 與 UploadReport 相同：先檢查欄位，再依對應表改為以 LOINC 代碼為鍵
+	for _, v := range validateLabResults(string(raw)) {
+		result.Errors = append(result.Errors, &pb.IngestFieldError{
+			SourceRef: observationSource(r, v.Field), Field: v.Field, Description: v.Description,
+		})
+	}
+	if len(result.Errors) > 0 {
+		return reject(rejectInvalidResults, "檢驗結果格式錯誤")
+	}
+	normalized, unmapped, violations, err := table.Normalize(string(raw))
+	if err != nil {
+		return reject(rejectInvalidResults, err.Error())
+	}
+	for _, v := range violations {
+		result.Errors = append(result.Errors, &pb.IngestFieldError{
+			SourceRef: observationSource(r, v.Field), Field: v.Field, Description: v.Description,
+		})
+	}
+	if len(result.Errors) > 0 {
+		return reject(rejectInvalidResults, "檢驗代碼對應錯誤")
+	}
+	result.UnmappedCodes = unmapped
+
+	patientHash := database.ResolveUserHash(r.PatientID)
+	if _, err := contract.Submit(
+		"UploadReport",
+		client.WithArguments(r.ReportID, patientHash),
+		client.WithTransient(resultTransient(normalized)),
+	); err != nil {
+		fc.PrintGatewayError(err)
+		msg := fc.ChaincodeMessage(err)
+		switch {
+		case strings.Contains(msg, "reportID already exists"):
+			return reject(rejectDuplicateReport, "報告ID已存在，更正請使用 AmendReport")
+		case strings.Contains(msg, "invalid lab results: "):
+			_, detail, _ := strings.Cut(msg, "invalid lab results: ")
+			return reject(rejectInvalidResults, "檢驗結果格式錯誤: "+detail)
+		default:
+			return reject(rejectChainError, "鏈上交易失敗")
+		}
+	}
+
+	result.Accepted = true
+	result.Message = "上傳成功"
+	return result
+}
+
+// observationSource 由欄位錯誤（代碼或 代碼.欄位）找回檢驗結果在原始訊息中的位置
+func observationSource(r ingest.Report, field string) string {
+	if ref, ok := r.SourceRefs[field]; ok {
+		return ref
+	}
+	best := ""
+	for code := range r.SourceRefs {
+		if strings.HasPrefix(field, code+".") && len(code) > len(best) {
+			best = code
+		}
+	}
+	if best != "" {
+		return r.SourceRefs[best]
+	}
+	return r.SourceRef
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go_server/analyte"
+	fc "go_server/fabric"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateLabResults 檢查結構化檢驗結果（報告內容以檢驗項目代碼為鍵），規則與鏈碼相同，回傳所有欄位的錯誤
+func validateLabResults(resultJSON string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	add := func(field, desc string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: desc})
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(resultJSON), &all); err != nil || all == nil {
+		add("test_results_json", "必須是以檢驗項目代碼為鍵的 JSON 物件")
+		return violations
+	}
+	if len(all) == 0 {
+		add("test_results_json", "至少需要一個檢驗項目")
+		return violations
+	}
+	allowed := make(map[string]bool, len(analyte.Fields))
+	for _, f := range analyte.Fields {
+		allowed[f] = true
+	}
+	codeList := make([]string, 0, len(all))
+	for code := range all {
+		codeList = append(codeList, code)
+	}
+	sort.Strings(codeList)
+
+	for _, code := range codeList {
+		if strings.TrimSpace(code) == "" {
+			add("test_results_json", "檢驗項目代碼不可為空")
+			continue
+		}
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(all[code], &keys); err != nil || keys == nil {
+			add(code, "必須是包含 value（或 text）、unit、referenceRange 與 flag 的物件")
+			continue
+		}
+		unknown := false
+		for k := range keys {
+			if !allowed[k] {
+				add(code+"."+k, "不支援的欄位")
+				unknown = true
+			}
+		}
+		if unknown {
+			continue
+		}
+		var r analyte.Result
+		if err := json.Unmarshal(all[code], &r); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) && typeErr.Field != "" {
+				add(code+"."+typeErr.Field, "必須是"+jsonKindName(typeErr.Type.Kind()))
+			} else {
+				add(code, err.Error())
+			}
+			continue
+		}
+		// 定性結果以 text 取代 value，單位可省略
+		switch {
+		case strings.TrimSpace(r.Text) != "":
+			if r.Flag != "A" {
+				add(code+".text", "僅限判讀為 A 的定性結果")
+			}
+			if r.Value != nil {
+				add(code+".value", "不可與 text 同時提供")
+			}
+		default:
+			if r.Value == nil {
+				add(code+".value", "必填")
+			}
+			if strings.TrimSpace(r.Unit) == "" {
+				add(code+".unit", "必填")
+			}
+		}
+		switch {
+		case r.ReferenceRange == nil:
+			add(code+".referenceRange", "必填")
+		case r.ReferenceRange.Low == nil && r.ReferenceRange.High == nil && strings.TrimSpace(r.ReferenceRange.Text) == "":
+			add(code+".referenceRange", "至少需提供 low、high 或 text")
+		case r.ReferenceRange.Low != nil && r.ReferenceRange.High != nil && *r.ReferenceRange.Low > *r.ReferenceRange.High:
+			add(code+".referenceRange", "low 不可大於 high")
+		}
+		switch r.Flag {
+		case "N", "L", "H", "LL", "HH", "A":
+		case "":
+			add(code+".flag", "必填")
+		default:
+			add(code+".flag", fmt.Sprintf("不支援的判讀 %q（N、L、H、LL、HH 或 A）", r.Flag))
+		}
+	}
+	return violations
+}
+
+func jsonKindName(k reflect.Kind) string {
+	switch k {
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64:
+		return "數字"
+	case reflect.String:
+		return "字串"
+	default:
+		return "物件"
+	}
+}
+
+// labResultError 將欄位錯誤轉為 InvalidArgument，訊息列出所有錯誤，並附上 BadRequest 細節供前端逐欄顯示
+func labResultError(violations []*errdetails.BadRequest_FieldViolation) error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	st := status.New(codes.InvalidArgument, "檢驗結果格式錯誤: "+strings.Join(msgs, "; "))
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// uploadReportError 將鏈碼拒絕上傳或更正的原因轉為對應的 gRPC 狀態
+func uploadReportError(err error, fallback string) error {
+	msg := fc.ChaincodeMessage(err)
+	if i := strings.Index(msg, "invalid lab results: "); i >= 0 {
+		return status.Error(codes.InvalidArgument, "檢驗結果格式錯誤: "+msg[i+len("invalid lab results: "):])
+	}
+	return status.Error(codes.Internal, fallback)
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"go_server/database"
+	fc "go_server/fabric"
+	pb "go_server/proto"
+	ut "go_server/utils"
+	wl "go_server/wallet"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminContract 確認 JWT 使用者為平台管理者，並以其身份建立合約
+func adminContract(
+	ctx context.Context,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*client.Contract, *client.Gateway, error) {
+
+	adminID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if ok, err := database.IsAdminExists(adminID); err != nil || !ok {
+		return nil, nil, status.Error(codes.PermissionDenied, "只有管理者可以執行此操作")
+	}
+
+	entry, ok := wallet.Get(adminID)
+	if !ok {
+		return nil, nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	return contract, gw, nil
+}
+
+// HandleRegisterClinic 處理管理者登錄或更新健檢中心
+func HandleRegisterClinic(
+	ctx context.Context,
+	req *pb.RegisterClinicRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.RegisterClinicResponse, error) {
+
+	if req.ClinicId == "" || req.Name == "" || req.LicenseNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供健檢中心ID、名稱與執照號碼")
+	}
+	if req.AccreditedUntil <= req.AccreditedFrom {
+		return nil, status.Error(codes.InvalidArgument, "認證效期不正確")
+	}
+
+	contract, gw, err := adminContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction(
+		"RegisterClinic",
+		req.ClinicId,
+		req.Name,
+		req.LicenseNumber,
+		strconv.FormatInt(req.AccreditedFrom, 10),
+		strconv.FormatInt(req.AccreditedUntil, 10),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "登錄健檢中心失敗")
+	}
+
+	return &pb.RegisterClinicResponse{
+		Success: true,
+		Message: "已登錄健檢中心",
+	}, nil
+}
+
+// HandleSuspendClinic 處理管理者停權健檢中心
+func HandleSuspendClinic(
+	ctx context.Context,
+	req *pb.SuspendClinicRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.SuspendClinicResponse, error) {
+
+	if req.ClinicId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供健檢中心ID與停權原因")
+	}
+
+	contract, gw, err := adminContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("SuspendClinic", req.ClinicId, req.Reason)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "停權健檢中心失敗")
+	}
+
+	return &pb.SuspendClinicResponse{
+		Success: true,
+		Message: "已停權健檢中心",
+	}, nil
+}
+
+// 對應鏈碼的 Clinic 結構
+type rawClinic struct {
+	ClinicID        string `json:"clinicId"`
+	Name            string `json:"name"`
+	LicenseNumber   string `json:"licenseNumber"`
+	AccreditedFrom  int64  `json:"accreditedFrom"`
+	AccreditedUntil int64  `json:"accreditedUntil"`
+	Status          string `json:"status"`
+	SuspendReason   string `json:"suspendReason"`
+	UpdatedAt       int64  `json:"updatedAt"`
+}
+
+// HandleGetClinic 查詢健檢中心登錄資料
+func HandleGetClinic(
+	ctx context.Context,
+	req *pb.GetClinicRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.GetClinicResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+	if req.ClinicId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供健檢中心ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("GetClinic", req.ClinicId)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.NotFound, "查無健檢中心")
+	}
+
+	var raw rawClinic
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+
+	return &pb.GetClinicResponse{
+		Success: true,
+		Clinic: &pb.Clinic{
+			ClinicId:        raw.ClinicID,
+			Name:            raw.Name,
+			LicenseNumber:   raw.LicenseNumber,
+			AccreditedFrom:  raw.AccreditedFrom,
+			AccreditedUntil: raw.AccreditedUntil,
+			Status:          raw.Status,
+			SuspendReason:   raw.SuspendReason,
+			UpdatedAt:       raw.UpdatedAt,
+		},
+	}, nil
+}
+
+// HandleRegisterInsurerLicense 處理管理者登錄或更新保險業者執照資料
+func HandleRegisterInsurerLicense(
+	ctx context.Context,
+	req *pb.RegisterInsurerLicenseRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.RegisterInsurerLicenseResponse, error) {
+
+	if req.InsurerId == "" || req.LegalName == "" || req.LicenseNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者帳號、公司名稱與執照號碼")
+	}
+	if ok, err := database.IsInsurerExists(req.InsurerId); err != nil || !ok {
+		return nil, status.Error(codes.NotFound, "保險業者帳號不存在")
+	}
+
+	contract, gw, err := adminContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction(
+		"RegisterInsurer",
+		database.ResolveInsurerHash(req.InsurerId),
+		req.LegalName,
+		req.LicenseNumber,
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "登錄保險業者失敗")
+	}
+
+	return &pb.RegisterInsurerLicenseResponse{
+		Success: true,
+		Message: "已登錄保險業者",
+	}, nil
+}
+
+// HandleSuspendInsurerLicense 處理管理者停權保險業者
+func HandleSuspendInsurerLicense(
+	ctx context.Context,
+	req *pb.SuspendInsurerLicenseRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.SuspendInsurerLicenseResponse, error) {
+
+	if req.InsurerId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者帳號與停權原因")
+	}
+
+	contract, gw, err := adminContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	_, err = contract.SubmitTransaction("SuspendInsurer", database.ResolveInsurerHash(req.InsurerId), req.Reason)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "停權保險業者失敗")
+	}
+
+	return &pb.SuspendInsurerLicenseResponse{
+		Success: true,
+		Message: "已停權保險業者",
+	}, nil
+}
+
+// 對應鏈碼的 Insurer 結構
+type rawInsurer struct {
+	InsurerHash   string `json:"insurerHash"`
+	LegalName     string `json:"legalName"`
+	LicenseNumber string `json:"licenseNumber"`
+	Status        string `json:"status"`
+	SuspendReason string `json:"suspendReason"`
+	UpdatedAt     int64  `json:"updatedAt"`
+}
+
+// HandleGetInsurerLicense 管理者查詢保險業者登錄資料
+func HandleGetInsurerLicense(
+	ctx context.Context,
+	req *pb.GetInsurerLicenseRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.GetInsurerLicenseResponse, error) {
+
+	if req.InsurerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供保險業者帳號")
+	}
+
+	contract, gw, err := adminContract(ctx, wallet, builder)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("GetInsurer", database.ResolveInsurerHash(req.InsurerId))
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.NotFound, "查無保險業者登錄資料")
+	}
+
+	var raw rawInsurer
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+
+	return &pb.GetInsurerLicenseResponse{
+		Success: true,
+		Insurer: &pb.InsurerLicense{
+			InsurerHash:   raw.InsurerHash,
+			LegalName:     raw.LegalName,
+			LicenseNumber: raw.LicenseNumber,
+			Status:        raw.Status,
+			SuspendReason: raw.SuspendReason,
+			UpdatedAt:     raw.UpdatedAt,
+		},
+	}, nil
+}
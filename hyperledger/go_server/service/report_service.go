@@ -2,11 +2,10 @@ package service
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"go_server/database"
@@ -15,11 +14,105 @@ import (
 	ut "go_server/utils"
 	wl "go_server/wallet"
 
+	"github.com/hyperledger/fabric-gateway/pkg/client"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// resultTransient 將報告內容包成鏈碼讀取的 transient data
+func resultTransient(resultJSON string) map[string][]byte {
+	return map[string][]byte{"resultJson": []byte(resultJSON)}
+}
+
+// fieldsArg 將欄位清單轉為鏈碼 []string 參數的 JSON 字串，空清單傳 "[]"
+func fieldsArg(fields []string) string {
+	if len(fields) == 0 {
+		return "[]"
+	}
+	b, _ := json.Marshal(fields)
+	return string(b)
+}
+
+// 對應鏈碼的 Predicate 結構
+type rawPredicate struct {
+	Field     string  `json:"field"`
+	Op        string  `json:"op"`
+	Value     float64 `json:"value"`
+	Component int32   `json:"component,omitempty"`
+}
+
+// predicatesArg 將條件轉為鏈碼 []Predicate 參數的 JSON 字串
+func predicatesArg(predicates []*pb.Predicate) string {
+	raws := make([]rawPredicate, 0, len(predicates))
+	for _, p := range predicates {
+		raws = append(raws, rawPredicate{Field: p.Field, Op: p.Op, Value: p.Value, Component: p.Component})
+	}
+	b, _ := json.Marshal(raws)
+	return string(b)
+}
+
+func toPbPredicates(raws []rawPredicate) []*pb.Predicate {
+	var out []*pb.Predicate
+	for _, r := range raws {
+		out = append(out, &pb.Predicate{Field: r.Field, Op: r.Op, Value: r.Value, Component: r.Component})
+	}
+	return out
+}
+
+// 對應鏈碼 ListFilter 結構
+type rawListFilter struct {
+	FromDate      int64  `json:"fromDate,omitempty"`
+	ToDate        int64  `json:"toDate,omitempty"`
+	ClinicID      string `json:"clinicId,omitempty"`
+	Status        string `json:"status,omitempty"`
+	PatientHash   string `json:"patientHash,omitempty"`
+	RequesterHash string `json:"requesterHash,omitempty"`
+}
+
+// 對應鏈碼的分頁結果，records 依各查詢再解析
+type rawPage struct {
+	Records      json.RawMessage `json:"records"`
+	Bookmark     string          `json:"bookmark"`
+	FetchedCount int32           `json:"fetchedCount"`
+}
+
+// pageArgs 組出鏈碼分頁查詢的 pageSize、bookmark、filter 參數
+func pageArgs(pageSize int32, bookmark string, filter rawListFilter) []string {
+	f, _ := json.Marshal(filter)
+	return []string{strconv.FormatInt(int64(pageSize), 10), bookmark, string(f)}
+}
+
+// listQueryArgs 將 ListQueryRequest 轉為鏈碼分頁查詢參數，patient_id 供代理人查詢被代理病患
+func listQueryArgs(req *pb.ListQueryRequest) []string {
+	filter := rawListFilter{
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		ClinicID: req.ClinicId,
+		Status:   req.Status,
+	}
+	if req.PatientId != "" {
+		filter.PatientHash = database.ResolveUserHash(req.PatientId)
+	}
+	if req.InsurerId != "" {
+		filter.RequesterHash = database.ResolveInsurerHash(req.InsurerId)
+	}
+	return pageArgs(req.PageSize, req.Bookmark, filter)
+}
+
+// parsePage 解析鏈碼分頁結果，records 寫入 out
+func parsePage(result []byte, out interface{}) (*rawPage, error) {
+	var page rawPage
+	if err := json.Unmarshal(result, &page); err != nil {
+		return nil, err
+	}
+	if len(page.Records) > 0 {
+		if err := json.Unmarshal(page.Records, out); err != nil {
+			return nil, err
+		}
+	}
+	return &page, nil
+}
+
 // HandleUploadReport 驗證請求 → 存 SQLite → 調用 Fabric
 func HandleUploadReport(
 	ctx context.Context,
@@ -31,15 +124,11 @@ func HandleUploadReport(
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("[Debug] UploadReport userID=%s", userID)
 	entry, ok := wallet.Get(userID)
 	if !ok {
 		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
 	}
 
-	log.Printf("[Debug] UploadReport args: reportID=%s, patientHash=%s, testResult=%s",
-		req.ReportId, req.UserId, req.TestResultsJson)
-
 	// 依使用者身分建立 Gateway + Contract
 	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
 	if err != nil {
@@ -47,43 +136,230 @@ func HandleUploadReport(
 	}
 	defer gw.Close()
 
-	sum := sha256.Sum256([]byte(req.UserId))
-	hashedUserID := hex.EncodeToString(sum[:])
-	log.Printf("[Debug] 查詢患者雜湊: %s", hashedUserID)
+	// 先在伺服器檢查結構化檢驗結果，逐欄回報錯誤，鏈碼會再以相同規則檢查一次
+	if violations := validateLabResults(req.TestResultsJson); len(violations) > 0 {
+		return nil, labResultError(violations)
+	}
+	// 依檢驗項目字典改為以 LOINC 代碼為鍵，原始代碼保留於 localCode
+	resultJSON, unmapped, err := normalizeLabResults(entry, req.TestResultsJson)
+	if err != nil {
+		return nil, err
+	}
 
-	// 呼叫鏈碼
-	log.Printf("[Debug] 準備調用 SubmitTransaction: UploadReport")
-	log.Printf("[Debug] 參數 - ReportID: %s, PatientHash: %s, DataSize: %d bytes", 
-		req.ReportId, hashedUserID, len(req.TestResultsJson))
-	
-	result, err := contract.SubmitTransaction(
+	hashedUserID := database.ResolveUserHash(req.UserId)
+
+	// 呼叫鏈碼（報告內容以 transient data 傳送，只寫入私有資料集合）
+	_, err = contract.Submit(
 		"UploadReport",
-		req.ReportId,
-		hashedUserID,
-		req.TestResultsJson,
+		client.WithArguments(req.ReportId, hashedUserID),
+		client.WithTransient(resultTransient(resultJSON)),
 	)
-	
+
 	if err != nil {
 		log.Printf("[Error] SubmitTransaction 失敗: %v", err)
 		fc.PrintGatewayError(err) // 看錯誤細節
-		return nil, status.Error(codes.Internal, "鏈上交易失敗")
+		return nil, uploadReportError(err, "鏈上交易失敗")
 	}
-	
-	log.Printf("[Debug] SubmitTransaction 成功完成, 結果: %s", string(result))
+	log.Printf("[Debug] UploadReport 成功: %s", req.ReportId)
 
 	return &pb.UploadReportResponse{
-		Success: true, Message: "上傳成功",
+		Success: true, Message: "上傳成功", UnmappedCodes: unmapped,
+	}, nil
+}
+
+// HandleAmendReport 健檢中心更正已上傳的報告，鏈上會保留舊版本
+func HandleAmendReport(
+	ctx context.Context,
+	req *pb.AmendReportRequest,
+	wallet wl.WalletInterface, builder fc.GWBuilder) (*pb.AmendReportResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReportId == "" || req.TestResultsJson == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID、更正內容與更正原因")
+	}
+	if violations := validateLabResults(req.TestResultsJson); len(violations) > 0 {
+		return nil, labResultError(violations)
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+	resultJSON, unmapped, err := normalizeLabResults(entry, req.TestResultsJson)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	result, err := contract.Submit(
+		"AmendReport",
+		client.WithArguments(req.ReportId, req.Reason),
+		client.WithTransient(resultTransient(resultJSON)),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, uploadReportError(err, "更正報告失敗")
+	}
+
+	version, _ := strconv.Atoi(string(result))
+	return &pb.AmendReportResponse{
+		Success:       true,
+		Message:       "報告已更正",
+		Version:       int32(version),
+		UnmappedCodes: unmapped,
+	}, nil
+}
+
+// HandleGetReportHistory 查詢報告的所有歷史版本
+func HandleGetReportHistory(
+	ctx context.Context,
+	req *pb.GetReportHistoryRequest,
+	wallet wl.WalletInterface, builder fc.GWBuilder) (*pb.GetReportHistoryResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析 JWT")
+	}
+
+	if req.ReportId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("GetReportHistory", req.ReportId)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢報告歷史失敗")
+	}
+
+	type rawReportVersion struct {
+		TxID        string `json:"txId"`
+		Timestamp   int64  `json:"timestamp"`
+		Version     int32  `json:"version"`
+		ResultJSON  string `json:"resultJson"`
+		ResultHash  string `json:"resultHash"`
+		AmendReason string `json:"amendReason"`
+		IsDelete    bool   `json:"isDelete"`
+	}
+
+	var rawList []rawReportVersion
+	if err := json.Unmarshal(result, &rawList); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+
+	var versions []*pb.ReportVersion
+	for _, r := range rawList {
+		versions = append(versions, &pb.ReportVersion{
+			TxId:        r.TxID,
+			Timestamp:   r.Timestamp,
+			Version:     r.Version,
+			ResultJson:  r.ResultJSON,
+			ResultHash:  r.ResultHash,
+			AmendReason: r.AmendReason,
+			IsDelete:    r.IsDelete,
+		})
+	}
+
+	return &pb.GetReportHistoryResponse{
+		Success:  true,
+		Versions: versions,
 	}, nil
 }
 
+// HandleGetReportAuditTrail 查詢報告、授權票據與授權請求的所有鏈上狀態變更
+func HandleGetReportAuditTrail(
+	ctx context.Context,
+	req *pb.GetReportAuditTrailRequest,
+	wallet wl.WalletInterface, builder fc.GWBuilder) (*pb.GetReportAuditTrailResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析 JWT")
+	}
+
+	if req.ReportId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("GetReportAuditTrail", req.ReportId)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢稽核軌跡失敗")
+	}
+
+	var raw struct {
+		ReportID string `json:"reportId"`
+		Entries  []struct {
+			ObjectType string `json:"objectType"`
+			ObjectKey  string `json:"objectKey"`
+			TxID       string `json:"txId"`
+			Timestamp  int64  `json:"timestamp"`
+			WriterMSP  string `json:"writerMsp"`
+			Operation  string `json:"operation"`
+			Status     string `json:"status"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+
+	var entries []*pb.AuditEntry
+	for _, e := range raw.Entries {
+		entries = append(entries, &pb.AuditEntry{
+			ObjectType: e.ObjectType,
+			ObjectKey:  e.ObjectKey,
+			TxId:       e.TxID,
+			Timestamp:  e.Timestamp,
+			WriterMsp:  e.WriterMSP,
+			Operation:  e.Operation,
+			Status:     e.Status,
+		})
+	}
+
+	log.Printf("[Info] 報告 %s 稽核紀錄 %d 筆", raw.ReportID, len(entries))
+	return &pb.GetReportAuditTrailResponse{
+		Success:  true,
+		ReportId: raw.ReportID,
+		Entries:  entries,
+	}, nil
+}
 
 // HandleRequestAccess 處理保險業者請求授權
 func HandleRequestAccess(
 	ctx context.Context,
 	req *pb.RequestAccessRequest,
-	wallet wl.WalletInterface, 
+	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.RequestAccessResponse, error) {
-	log.Printf("[Debug] HandleRequestAccess", req)
 	// 取得JWT中的使用者ID（應為保險業者）
 	requesterId, err := ut.ExtractUserIDFromContext(ctx)
 	if err != nil {
@@ -100,6 +376,9 @@ func HandleRequestAccess(
 	if req.ReportId == "" || req.PatientId == "" || req.Reason == "" {
 		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID、病患ID和申請原因")
 	}
+	if len(req.Predicates) > 0 && len(req.RequestedFields) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "條件授權與欄位授權不可同時申請")
+	}
 
 	// 設定過期時間，若未提供則預設30天
 	expiry := req.Expiry
@@ -119,44 +398,82 @@ func HandleRequestAccess(
 	}
 	defer gw.Close()
 
-	// 計算病患雜湊
-	sum := sha256.Sum256([]byte(req.PatientId))
-	patientHash := hex.EncodeToString(sum[:])
-
-	// 呼叫鏈碼
-	_, err = contract.SubmitTransaction(
-		"RequestAccess",
-		req.ReportId,
-		patientHash,
-		req.Reason,
-		strconv.FormatInt(expiry, 10),
-	)
+	// 取得病患鏈上假名
+	patientHash := database.ResolveUserHash(req.PatientId)
+
+	// 呼叫鏈碼；帶有條件時改為條件授權請求
+	var result []byte
+	if len(req.Predicates) > 0 {
+		result, err = contract.SubmitTransaction(
+			"RequestPredicateAccess",
+			req.ReportId,
+			patientHash,
+			req.Reason,
+			strconv.FormatInt(expiry, 10),
+			predicatesArg(req.Predicates),
+		)
+	} else {
+		result, err = contract.SubmitTransaction(
+			"RequestAccess",
+			req.ReportId,
+			patientHash,
+			req.Reason,
+			strconv.FormatInt(expiry, 10),
+			fieldsArg(req.RequestedFields),
+		)
+	}
 	if err != nil {
 		fc.PrintGatewayError(err)
-		return nil, status.Error(codes.Internal, "授權請求失敗")
+		return nil, requestAccessError(err, "授權請求失敗")
 	}
 
 	return &pb.RequestAccessResponse{
-		Success: true,
-		Message: "授權請求已送出",
+		Success:   true,
+		Message:   "授權請求已送出",
+		RequestId: string(result),
 	}, nil
 }
 
+// 對應鏈碼 ReadMyReport / ReadAuthorizedReport 回傳的 ReportContent
+type rawReportContent struct {
+	ReportID      string `json:"reportId"`
+	ClinicID      string `json:"clinicId"`
+	Version       int32  `json:"version"`
+	ResultJSON    string `json:"resultJson"`
+	CreatedAt     int64  `json:"createdAt"`
+	AmendedAt     int64  `json:"amendedAt"`
+	SchemaVersion int32  `json:"schemaVersion"`
+}
+
 type rawAccessRequest struct {
-	DocType      string `json:"docType"`
-	RequestID    string `json:"requestId"`
-	ReportID     string `json:"reportId"`
-	PatientHash  string `json:"patientHash"`
-	RequesterHash string `json:"requesterHash"`
-	Reason       string `json:"reason"`
-	RequestedAt  int64  `json:"requestedAt"`
-	Expiry       int64  `json:"expiry"`
-	Status       string `json:"status"`
+	DocType          string         `json:"docType"`
+	RequestID        string         `json:"requestId"`
+	RequestType      string         `json:"requestType"`
+	ReportID         string         `json:"reportId"`
+	PatientHash      string         `json:"patientHash"`
+	RequesterHash    string         `json:"requesterHash"`
+	RequesterCompany string         `json:"requesterCompany"`
+	RequesterLicense string         `json:"requesterLicense"`
+	Reason           string         `json:"reason"`
+	RequestedAt      int64          `json:"requestedAt"`
+	Expiry           int64          `json:"expiry"`
+	Status           string         `json:"status"`
+	RequestedFields  []string       `json:"requestedFields"`
+	AccessMode       string         `json:"accessMode"`
+	Predicates       []rawPredicate `json:"predicates"`
+	ResponseDeadline int64          `json:"responseDeadline"`
+	DecidedBy        string         `json:"decidedBy"`
+	DecidedAt        int64          `json:"decidedAt"`
+	PolicyID         string         `json:"policyId"`
+	GrantedFields    []string       `json:"grantedFields"`
+	GrantedExpiry    int64          `json:"grantedExpiry"`
+	GrantedMaxReads  int32          `json:"grantedMaxReads"`
 }
+
 // HandleListAccessRequests 列出病患的所有授權請求
 func HandleListAccessRequests(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	req *pb.ListQueryRequest,
 	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.ListAccessRequestsResponse, error) {
 
@@ -178,52 +495,181 @@ func HandleListAccessRequests(
 	defer gw.Close()
 
 	// 呼叫鏈碼
-	result, err := contract.EvaluateTransaction("ListPendingAccessRequests")
+	result, err := contract.EvaluateTransaction("ListPendingAccessRequests", listQueryArgs(req)...)
 	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢失敗")
 	}
-	log.Printf("[Debug] 查詢到授權請求: %s", string(result))
 
-	
 	var raws []rawAccessRequest
-	if err := json.Unmarshal(result, &raws); err != nil {
+	page, err := parsePage(result, &raws)
+	if err != nil {
 		return nil, status.Error(codes.Internal, "解析結果失敗")
 	}
 
 	var requests []*pb.AccessRequest
 	for _, r := range raws {
-		// 從資料庫獲取保險業者資訊
-		// 注意：這裡的 RequesterHash 已經是雜湊值，直接使用
-		log.Printf("[Debug] 嘗試使用鏈碼返回的 RequesterHash 查詢資料庫: %s", r.RequesterHash)
-		insurer, err := database.GetInsurerByHash(r.RequesterHash)
-		if err != nil {
-			log.Printf("[Warning] 無法獲取保險業者資訊: %v", err)
-			// 繼續處理其他請求
-			continue
+		// 公司名稱與執照號碼取自鏈上登錄資料，資料庫只補充聯絡人姓名
+		var contactPerson string
+		if insurer, err := database.GetInsurerByHash(r.RequesterHash); err == nil {
+			contactPerson = insurer.Name
+		} else {
+			log.Printf("[Warning] 無法獲取保險業者聯絡人: %v", err)
 		}
 
 		requests = append(requests, &pb.AccessRequest{
-			RequestId:     r.RequestID,
-			ReportId:      r.ReportID,
-			PatientHash:   r.PatientHash,
-			RequesterHash: r.RequesterHash,
-			RequesterName: insurer.Name,
-			CompanyName:   insurer.CompanyName,
-			Reason:        r.Reason,
-			RequestedAt:   r.RequestedAt,
-			Expiry:        r.Expiry,
-			Status:        r.Status,
+			RequestId:        r.RequestID,
+			ReportId:         r.ReportID,
+			PatientHash:      r.PatientHash,
+			RequesterHash:    r.RequesterHash,
+			RequesterName:    contactPerson,
+			CompanyName:      r.RequesterCompany,
+			CompanyLicense:   r.RequesterLicense,
+			Reason:           r.Reason,
+			RequestedAt:      r.RequestedAt,
+			Expiry:           r.Expiry,
+			Status:           r.Status,
+			RequestedFields:  r.RequestedFields,
+			AccessMode:       r.AccessMode,
+			Predicates:       toPbPredicates(r.Predicates),
+			ResponseDeadline: r.ResponseDeadline,
+			DecidedBy:        r.DecidedBy,
+			PolicyId:         r.PolicyID,
+			GrantedFields:    r.GrantedFields,
+			GrantedExpiry:    r.GrantedExpiry,
+			GrantedMaxReads:  r.GrantedMaxReads,
+			RequestType:      r.RequestType,
+			DecidedAt:        r.DecidedAt,
 		})
 	}
 
-	log.Printf("[Debug] 查詢到授權請求: %v", requests)
+	return &pb.ListAccessRequestsResponse{
+		Requests:     requests,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
+	}, nil
+}
+
+// 對應鏈碼 AccessRequestHistoryEntry 結構
+type rawAccessRequestHistoryEntry struct {
+	Request      rawAccessRequest `json:"request"`
+	TicketStatus string           `json:"ticketStatus"`
+	TicketExpiry int64            `json:"ticketExpiry"`
+}
+
+// HandleListMyAccessRequestHistory 列出病患所有的授權請求（含已處理），可依保險業者與狀態篩選
+func HandleListMyAccessRequestHistory(
+	ctx context.Context,
+	req *pb.ListQueryRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.ListAccessRequestsResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("ListMyAccessRequestHistory", listQueryArgs(req)...)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "查詢授權請求歷史失敗")
+	}
+
+	var raws []rawAccessRequestHistoryEntry
+	page, err := parsePage(result, &raws)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解析結果失敗")
+	}
+
+	requests := []*pb.AccessRequest{}
+	contacts := map[string]string{}
+	for _, h := range raws {
+		r := h.Request
+		contactPerson, ok := contacts[r.RequesterHash]
+		if !ok {
+			if insurer, err := database.GetInsurerByHash(r.RequesterHash); err == nil {
+				contactPerson = insurer.Name
+			} else {
+				log.Printf("[Warning] 無法獲取保險業者聯絡人: %v", err)
+			}
+			contacts[r.RequesterHash] = contactPerson
+		}
+
+		requests = append(requests, &pb.AccessRequest{
+			RequestId:        r.RequestID,
+			ReportId:         r.ReportID,
+			PatientHash:      r.PatientHash,
+			RequesterHash:    r.RequesterHash,
+			RequesterName:    contactPerson,
+			CompanyName:      r.RequesterCompany,
+			CompanyLicense:   r.RequesterLicense,
+			Reason:           r.Reason,
+			RequestedAt:      r.RequestedAt,
+			Expiry:           r.Expiry,
+			Status:           r.Status,
+			RequestedFields:  r.RequestedFields,
+			AccessMode:       r.AccessMode,
+			Predicates:       toPbPredicates(r.Predicates),
+			ResponseDeadline: r.ResponseDeadline,
+			DecidedBy:        r.DecidedBy,
+			DecidedAt:        r.DecidedAt,
+			PolicyId:         r.PolicyID,
+			GrantedFields:    r.GrantedFields,
+			GrantedExpiry:    r.GrantedExpiry,
+			GrantedMaxReads:  r.GrantedMaxReads,
+			RequestType:      r.RequestType,
+			TicketStatus:     h.TicketStatus,
+			TicketExpiry:     h.TicketExpiry,
+		})
+	}
 
 	return &pb.ListAccessRequestsResponse{
-		Requests: requests,
+		Requests:     requests,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
+// decideAccessError 將鏈碼拒絕批准、拒絕或撤銷授權的原因轉為對應的 gRPC 狀態
+func decideAccessError(err error, fallback string) error {
+	msg := fc.ChaincodeMessage(err)
+	switch {
+	case strings.Contains(msg, "request not found"):
+		return status.Error(codes.NotFound, "授權請求不存在")
+	case strings.Contains(msg, "ticket not found"):
+		return status.Error(codes.NotFound, "授權票據不存在")
+	case strings.Contains(msg, "not authorized"),
+		strings.Contains(msg, "only patient or delegate"),
+		strings.Contains(msg, "delegation does not cover"),
+		strings.Contains(msg, "delegation is no longer valid"):
+		return status.Error(codes.PermissionDenied, "無權處理此病患的授權")
+	case strings.Contains(msg, "already handled"):
+		return status.Error(codes.FailedPrecondition, "授權請求已處理")
+	case strings.Contains(msg, "already revoked"):
+		return status.Error(codes.FailedPrecondition, "授權票據已撤銷")
+	case strings.Contains(msg, "deadline has passed"):
+		return status.Error(codes.FailedPrecondition, "已超過授權請求的回應期限")
+	case strings.Contains(msg, "was not requested"),
+		strings.Contains(msg, "does not apply to"),
+		strings.Contains(msg, "granted expiry must"),
+		strings.Contains(msg, "max reads must not be negative"):
+		return status.Error(codes.InvalidArgument, "授權內容不符合請求: "+msg)
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
 // HandleApproveAccessRequest 處理授權請求的批准
 func HandleApproveAccessRequest(
 	ctx context.Context,
@@ -235,6 +681,12 @@ func HandleApproveAccessRequest(
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
 	}
+	if req.GrantedExpiry != 0 && req.GrantedExpiry <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "核准的到期時間必須晚於現在")
+	}
+	if req.MaxReads < 0 {
+		return nil, status.Error(codes.InvalidArgument, "讀取次數上限不可為負數")
+	}
 
 	entry, ok := wallet.Get(userID)
 	if !ok {
@@ -248,14 +700,16 @@ func HandleApproveAccessRequest(
 	defer gw.Close()
 
 	// 呼叫鏈碼
-	log.Printf("[Debug] 批准授權請求: %s", req.RequestId)
 	_, err = contract.SubmitTransaction(
-		"ApproveAndAuthorizeAccess", 
+		"ApproveAndAuthorizeAccess",
 		req.RequestId,
+		fieldsArg(req.GrantedFields),
+		strconv.FormatInt(req.GrantedExpiry, 10),
+		strconv.FormatInt(int64(req.MaxReads), 10),
 	)
 	if err != nil {
 		fc.PrintGatewayError(err)
-		return nil, status.Error(codes.Internal, "更新授權狀態失敗")
+		return nil, decideAccessError(err, "更新授權狀態失敗")
 	}
 
 	return &pb.ApproveAccessRequestResponse{
@@ -294,7 +748,7 @@ func HandleRejectAccessRequest(
 	)
 	if err != nil {
 		fc.PrintGatewayError(err)
-		return nil, status.Error(codes.Internal, "拒絕授權請求失敗")
+		return nil, decideAccessError(err, "拒絕授權請求失敗")
 	}
 
 	return &pb.RejectAccessRequestResponse{
@@ -303,10 +757,55 @@ func HandleRejectAccessRequest(
 	}, nil
 }
 
+// HandleRevokeAccessTicket 處理病患撤銷已核發的授權票據
+func HandleRevokeAccessTicket(
+	ctx context.Context,
+	req *pb.RevokeAccessTicketRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.RevokeAccessTicketResponse, error) {
+
+	userID, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	if req.TargetHash == "" || req.ReportId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供被授權者雜湊與報告ID")
+	}
+
+	entry, ok := wallet.Get(userID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, err
+	}
+	defer gw.Close()
+
+	// 呼叫鏈碼，代理人撤銷時傳入被代理病患的假名
+	_, err = contract.SubmitTransaction(
+		"RevokeAccess",
+		req.TargetHash,
+		req.ReportId,
+		actingPatientHash(req.PatientId),
+	)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, decideAccessError(err, "撤銷授權失敗")
+	}
+
+	return &pb.RevokeAccessTicketResponse{
+		Success: true,
+		Message: "已撤銷授權",
+	}, nil
+}
+
 // HandleListAuthorizedReports 獲取已授權的報告列表
 func HandleListAuthorizedReports(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	req *pb.ListQueryRequest,
 	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.ListAuthorizedReportsResponse, error) {
 
@@ -336,7 +835,7 @@ func HandleListAuthorizedReports(
 	defer gw.Close()
 
 	// 呼叫智能合約方法
-	result, err := contract.EvaluateTransaction("ListAuthorizedReports")
+	result, err := contract.EvaluateTransaction("ListAuthorizedReports", listQueryArgs(req)...)
 	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢授權報告失敗")
@@ -344,7 +843,8 @@ func HandleListAuthorizedReports(
 
 	// 解析鏈碼回傳的JSON結果
 	var rawList []map[string]interface{}
-	if err := json.Unmarshal(result, &rawList); err != nil {
+	page, err := parsePage(result, &rawList)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
 	}
 
@@ -352,7 +852,6 @@ func HandleListAuthorizedReports(
 	var reports []*pb.AuthorizedReport
 	for _, r := range rawList {
 		// 從資料庫獲取病患資訊（將 PatientHash 轉換為真實姓名）
-		log.Printf("[Debug] 嘗試使用鏈碼返回的 PatientHash 查詢資料庫: %s", r["patientHash"])
 		user, err := database.GetUserByHash(r["patientHash"].(string))
 		var patientName string
 		if err != nil {
@@ -364,37 +863,73 @@ func HandleListAuthorizedReports(
 
 		// 將時間戳轉換為日期字串，並處理 nil 的情況
 		var createdAt, expiry int64
-		
+
 		if r["createdAt"] != nil {
 			createdAt = int64(r["createdAt"].(float64))
 		} else {
 			createdAt = time.Now().Unix()
 		}
-		
+
 		if r["expiry"] != nil {
 			expiry = int64(r["expiry"].(float64))
 		} else {
 			// 如果沒有設定過期時間，預設為創建時間加上 30 天
 			expiry = createdAt + (30 * 24 * 60 * 60)
 		}
-		
+
 		date := time.Unix(createdAt, 0).Format("2006-01-02")
 		expiryDate := time.Unix(expiry, 0).Format("2006-01-02")
-		
+
+		var version int32 = 1
+		if v, ok := r["version"].(float64); ok {
+			version = int32(v)
+		}
+
+		accessMode, _ := r["accessMode"].(string)
+		var predicates []rawPredicate
+		if r["predicates"] != nil {
+			if b, err := json.Marshal(r["predicates"]); err == nil {
+				_ = json.Unmarshal(b, &predicates)
+			}
+		}
+
+		var readCount, maxReads int32
+		if v, ok := r["readCount"].(float64); ok {
+			readCount = int32(v)
+		}
+		if v, ok := r["maxReads"].(float64); ok {
+			maxReads = int32(v)
+		}
+
+		var grantedFields []string
+		if fs, ok := r["grantedFields"].([]interface{}); ok {
+			for _, f := range fs {
+				if name, ok := f.(string); ok {
+					grantedFields = append(grantedFields, name)
+				}
+			}
+		}
+
 		report := &pb.AuthorizedReport{
-			ReportId:    r["reportId"].(string),
-			PatientId:   r["patientHash"].(string),
-			PatientName: patientName, // 添加病患真實姓名
-			Date:        date,
-			Expiry:      expiryDate,
+			ReportId:      r["reportId"].(string),
+			PatientId:     r["patientHash"].(string),
+			PatientName:   patientName, // 添加病患真實姓名
+			Date:          date,
+			Expiry:        expiryDate,
+			Version:       version,
+			GrantedFields: grantedFields,
+			AccessMode:    accessMode,
+			Predicates:    toPbPredicates(predicates),
+			ReadCount:     readCount,
+			MaxReads:      maxReads,
 		}
 		reports = append(reports, report)
 	}
 
-	log.Printf("[Info] 已授權報告: %v", reports)
-
 	return &pb.ListAuthorizedReportsResponse{
-		Reports: reports,
+		Reports:      reports,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
@@ -411,10 +946,12 @@ func HandleListReportMetaByPatientID(
 		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
 	}
 
-	// 檢查是否為有效的保險業者
+	// 檢查是否為有效的保險業者或急診人員
 	_, err = database.GetInsurerPassword(insurerId)
 	if err != nil {
-		return nil, status.Error(codes.PermissionDenied, "只有保險業者可以查詢病患報告元數據")
+		if ok, _ := database.IsEmergencyStaffExists(insurerId); !ok {
+			return nil, status.Error(codes.PermissionDenied, "只有保險業者或急診人員可以查詢病患報告元數據")
+		}
 	}
 
 	// 檢查請求
@@ -436,7 +973,12 @@ func HandleListReportMetaByPatientID(
 	defer gw.Close()
 
 	// 呼叫智能合約方法
-	result, err := contract.EvaluateTransaction("ListReportMetaByPatientID", req.PatientId)
+	result, err := contract.EvaluateTransaction("ListReportMetaByPatientID",
+		append([]string{database.ResolveUserHash(req.PatientId)}, pageArgs(req.PageSize, req.Bookmark, rawListFilter{
+			FromDate: req.FromDate,
+			ToDate:   req.ToDate,
+			ClinicID: req.ClinicId,
+		})...)...)
 	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢病患報告元數據失敗")
@@ -444,13 +986,17 @@ func HandleListReportMetaByPatientID(
 
 	// 解析鏈碼回傳的JSON結果
 	type rawReportMeta struct {
-		ReportID  string `json:"reportId"`
-		ClinicID  string `json:"clinicId"`
-		CreatedAt int64  `json:"createdAt"`
+		ReportID      string `json:"reportId"`
+		ClinicID      string `json:"clinicId"`
+		ClinicName    string `json:"clinicName"`
+		CreatedAt     int64  `json:"createdAt"`
+		Version       int32  `json:"version"`
+		SchemaVersion int32  `json:"schemaVersion"`
 	}
 
 	var rawList []rawReportMeta
-	if err := json.Unmarshal(result, &rawList); err != nil {
+	page, err := parsePage(result, &rawList)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
 	}
 
@@ -458,19 +1004,49 @@ func HandleListReportMetaByPatientID(
 	var reports []*pb.ReportMeta
 	for _, r := range rawList {
 		reports = append(reports, &pb.ReportMeta{
-			ReportId:  r.ReportID,
-			ClinicId:  r.ClinicID,
-			CreatedAt: r.CreatedAt,
+			ReportId:      r.ReportID,
+			ClinicId:      r.ClinicID,
+			ClinicName:    r.ClinicName,
+			CreatedAt:     r.CreatedAt,
+			Version:       r.Version,
+			SchemaVersion: r.SchemaVersion,
 		})
 	}
 
 	log.Printf("[Info] 查詢到病患 %s 的報告元數據 %d 筆", req.PatientId, len(reports))
-	log.Printf("[Info] 數據: %v", reports)
 	return &pb.ListReportMetaResponse{
-		Reports: reports,
+		Reports:      reports,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
+// readAuthorizedReport 保險業者憑票據讀取報告：先以提交交易寫入讀取收據，待交易提交後再以查詢交易憑收據讀取。
+// 報告內容只出現在查詢交易的回應，不會寫入區塊
+func readAuthorizedReport(contract *client.Contract, patientHash, reportID string) (*rawReportContent, string, error) {
+	receipt, err := contract.SubmitTransaction("RecordReportRead", patientHash, reportID)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		if strings.Contains(fc.ChaincodeMessage(err), "read limit reached") {
+			return nil, "", status.Error(codes.ResourceExhausted, "已達票據的讀取次數上限")
+		}
+		return nil, "", status.Error(codes.Internal, "寫入讀取紀錄失敗")
+	}
+	receiptID := string(receipt)
+
+	result, err := contract.EvaluateTransaction("ReadAuthorizedReport", receiptID)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, "", status.Error(codes.Internal, "讀取報告失敗")
+	}
+
+	var content rawReportContent
+	if err := json.Unmarshal(result, &content); err != nil {
+		return nil, "", status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+	return &content, receiptID, nil
+}
+
 // ViewAuthorizedReport 實現保險業者讀取授權報告的服務
 func HandleViewAuthorizedReport(
 	ctx context.Context,
@@ -478,7 +1054,6 @@ func HandleViewAuthorizedReport(
 	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.ViewAuthorizedReportResponse, error) {
 
-	log.Printf("[Debug] HandleViewAuthorizedReport %s", req)
 	// 取得JWT中的使用者ID（保險業者）
 	insurerId, err := ut.ExtractUserIDFromContext(ctx)
 	if err != nil {
@@ -509,27 +1084,104 @@ func HandleViewAuthorizedReport(
 	}
 	defer gw.Close()
 
-	log.Printf("[Debug] HandleViewAuthorizedReport %s", req)
-	// 呼叫智能合約方法
-	result, err := contract.EvaluateTransaction("ReadAuthorizedReport", req.UserId, req.ReportId)
+	content, receiptID, err := readAuthorizedReport(contract, req.UserId, req.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewAuthorizedReportResponse{
+		Success:       true,
+		ResultJson:    content.ResultJSON,
+		Version:       content.Version,
+		ReceiptId:     receiptID,
+		SchemaVersion: content.SchemaVersion,
+	}, nil
+}
+
+// 對應鏈碼 EvaluateAuthorizedPredicates 回傳的 PredicateAttestation
+type rawPredicateAttestation struct {
+	ReportID    string `json:"reportId"`
+	ClinicID    string `json:"clinicId"`
+	Version     int32  `json:"version"`
+	EvaluatedAt int64  `json:"evaluatedAt"`
+	Results     []struct {
+		rawPredicate
+		Satisfied bool `json:"satisfied"`
+		Evaluable bool `json:"evaluable"`
+	} `json:"results"`
+}
+
+// HandleEvaluateAuthorizedPredicates 處理保險業者評估條件授權，只回傳布林結果
+func HandleEvaluateAuthorizedPredicates(
+	ctx context.Context,
+	req *pb.EvaluateAuthorizedPredicatesRequest,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder) (*pb.EvaluateAuthorizedPredicatesResponse, error) {
+
+	// 取得JWT中的使用者ID（保險業者）
+	insurerId, err := ut.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
+	}
+
+	// 檢查是否為有效的保險業者
+	_, err = database.GetInsurerPassword(insurerId)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "只有保險業者可以評估條件授權")
+	}
+
+	if req.ReportId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "必須提供報告ID和病患ID")
+	}
+
+	entry, ok := wallet.Get(insurerId)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "錢包不存在")
+	}
+
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "區塊鏈連接失敗")
+	}
+	defer gw.Close()
+
+	result, err := contract.EvaluateTransaction("EvaluateAuthorizedPredicates", req.UserId, req.ReportId)
 	if err != nil {
 		fc.PrintGatewayError(err)
-		return nil, status.Error(codes.Internal, "查詢病患報告元數據失敗")
+		return nil, status.Error(codes.Internal, "評估條件授權失敗")
 	}
 
-	
-	log.Printf("[Info] 查詢到報告: %s", string(result))
+	var raw rawPredicateAttestation
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
 
-	return &pb.ViewAuthorizedReportResponse{
-		Success: true,
-		ResultJson: string(result),
+	var results []*pb.PredicateResult
+	for _, r := range raw.Results {
+		results = append(results, &pb.PredicateResult{
+			Field:     r.Field,
+			Op:        r.Op,
+			Value:     r.Value,
+			Component: r.Component,
+			Satisfied: r.Satisfied,
+			Evaluable: r.Evaluable,
+		})
+	}
+
+	return &pb.EvaluateAuthorizedPredicatesResponse{
+		Success:     true,
+		ReportId:    raw.ReportID,
+		ClinicId:    raw.ClinicID,
+		Version:     raw.Version,
+		EvaluatedAt: raw.EvaluatedAt,
+		Results:     results,
 	}, nil
 }
 
 // HandleListMyAccessRequests 處理保險業者查看自己發出的授權請求
 func HandleListMyAccessRequests(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	req *pb.ListQueryRequest,
 	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.ListMyAccessRequestsResponse, error) {
 
@@ -559,7 +1211,7 @@ func HandleListMyAccessRequests(
 	defer gw.Close()
 
 	// 呼叫智能合約方法
-	result, err := contract.EvaluateTransaction("ListMyAccessRequests")
+	result, err := contract.EvaluateTransaction("ListMyAccessRequests", listQueryArgs(req)...)
 	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢授權請求失敗")
@@ -567,7 +1219,8 @@ func HandleListMyAccessRequests(
 
 	// 解析鏈碼回傳的JSON結果
 	var raws []rawAccessRequest
-	if err := json.Unmarshal(result, &raws); err != nil {
+	page, err := parsePage(result, &raws)
+	if err != nil {
 		return nil, status.Error(codes.Internal, "解析結果失敗")
 	}
 
@@ -575,7 +1228,6 @@ func HandleListMyAccessRequests(
 	var requests []*pb.AccessRequest
 	for _, r := range raws {
 		// 從資料庫獲取用戶資訊（將 PatientHash 轉換為真實姓名）
-		log.Printf("[Debug] 嘗試使用鏈碼返回的 PatientHash 查詢資料庫: %s", r.PatientHash)
 		user, err := database.GetUserByHash(r.PatientHash)
 		var patientName string
 		if err != nil {
@@ -586,40 +1238,68 @@ func HandleListMyAccessRequests(
 		}
 
 		requests = append(requests, &pb.AccessRequest{
-			RequestId:     r.RequestID,
-			ReportId:      r.ReportID,
-			PatientHash:   r.PatientHash,
-			RequesterHash: r.RequesterHash,
-			PatientName:   patientName, // 添加用戶真實姓名
-			Reason:        r.Reason,
-			RequestedAt:   r.RequestedAt,
-			Expiry:        r.Expiry,
-			Status:        r.Status,
+			RequestId:        r.RequestID,
+			ReportId:         r.ReportID,
+			PatientHash:      r.PatientHash,
+			RequesterHash:    r.RequesterHash,
+			PatientName:      patientName, // 添加用戶真實姓名
+			CompanyName:      r.RequesterCompany,
+			CompanyLicense:   r.RequesterLicense,
+			Reason:           r.Reason,
+			RequestedAt:      r.RequestedAt,
+			Expiry:           r.Expiry,
+			Status:           r.Status,
+			RequestedFields:  r.RequestedFields,
+			AccessMode:       r.AccessMode,
+			Predicates:       toPbPredicates(r.Predicates),
+			ResponseDeadline: r.ResponseDeadline,
+			DecidedBy:        r.DecidedBy,
+			PolicyId:         r.PolicyID,
+			GrantedFields:    r.GrantedFields,
+			GrantedExpiry:    r.GrantedExpiry,
+			GrantedMaxReads:  r.GrantedMaxReads,
+			RequestType:      r.RequestType,
+			DecidedAt:        r.DecidedAt,
 		})
 	}
 
 	return &pb.ListMyAccessRequestsResponse{
-		Success: true,
-		Requests: requests,
+		Success:      true,
+		Requests:     requests,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
 // 添加中間結構以匹配鏈碼的 AuthTicket 結構
 type rawAuthTicket struct {
-	DocType     string `json:"docType"`
-	PatientHash string `json:"patientHash"`
-	TargetHash  string `json:"targetHash"`
-	ReportID    string `json:"reportId"`
-	GrantedAt   int64  `json:"grantedAt"`
-	Expiry      int64  `json:"expiry"`
+	DocType        string         `json:"docType"`
+	PatientHash    string         `json:"patientHash"`
+	TargetHash     string         `json:"targetHash"`
+	ReportID       string         `json:"reportId"`
+	GrantedAt      int64          `json:"grantedAt"`
+	Expiry         int64          `json:"expiry"`
+	Revoked        bool           `json:"revoked"`
+	RevokedAt      int64          `json:"revokedAt"`
+	ExtendedAt     int64          `json:"extendedAt"`
+	PreviousExpiry int64          `json:"previousExpiry"`
+	MaxReads       int32          `json:"maxReads"`
+	ReadCount      int32          `json:"readCount"`
+	LastReadAt     int64          `json:"lastReadAt"`
+	GrantedBy      string         `json:"grantedBy"`
+	RevokedBy      string         `json:"revokedBy"`
+	PolicyID       string         `json:"policyId"`
+	GrantedFields  []string       `json:"grantedFields"`
+	AccessMode     string         `json:"accessMode"`
+	Predicates     []rawPredicate `json:"predicates"`
 }
 
 func HandleListMyAuthorizedTickets(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	req *pb.ListQueryRequest,
 	wallet wl.WalletInterface,
 	builder fc.GWBuilder) (*pb.ListAuthorizedTicketsResponse, error) {
-	
+
 	userID, err := ut.ExtractUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "無法解析授權資訊")
@@ -636,15 +1316,16 @@ func HandleListMyAuthorizedTickets(
 	}
 	defer gw.Close()
 
-	result, err := contract.EvaluateTransaction("ListMyAuthorizedTickets")
-    if err != nil {
+	result, err := contract.EvaluateTransaction("ListMyAuthorizedTickets", listQueryArgs(req)...)
+	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢授權請求失敗")
 	}
 
 	// 先解析為中間結構
 	var raws []rawAuthTicket
-	if err := json.Unmarshal(result, &raws); err != nil {
+	page, err := parsePage(result, &raws)
+	if err != nil {
 		return nil, status.Error(codes.Internal, "解析結果失敗")
 	}
 
@@ -657,42 +1338,68 @@ func HandleListMyAuthorizedTickets(
 			log.Printf("[Warning] 無法獲取保險業者資訊: %v", err)
 			// 如果無法獲取保險業者資訊，仍然添加票據，但不包含名稱資訊
 			tickets = append(tickets, &pb.AuthTicket{
-				PatientHash: r.PatientHash,
-				TargetHash:  r.TargetHash,
-				ReportId:    r.ReportID,
-				GrantedAt:   r.GrantedAt,
-				Expiry:      r.Expiry,
+				PatientHash:    r.PatientHash,
+				TargetHash:     r.TargetHash,
+				ReportId:       r.ReportID,
+				GrantedAt:      r.GrantedAt,
+				Expiry:         r.Expiry,
+				Revoked:        r.Revoked,
+				RevokedAt:      r.RevokedAt,
+				GrantedFields:  r.GrantedFields,
+				AccessMode:     r.AccessMode,
+				Predicates:     toPbPredicates(r.Predicates),
+				GrantedBy:      r.GrantedBy,
+				RevokedBy:      r.RevokedBy,
+				PolicyId:       r.PolicyID,
+				ExtendedAt:     r.ExtendedAt,
+				PreviousExpiry: r.PreviousExpiry,
+				MaxReads:       r.MaxReads,
+				ReadCount:      r.ReadCount,
+				LastReadAt:     r.LastReadAt,
 			})
 			continue
 		}
 
 		tickets = append(tickets, &pb.AuthTicket{
-			PatientHash:  r.PatientHash,
-			TargetHash:   r.TargetHash,
-			ReportId:     r.ReportID,
-			GrantedAt:    r.GrantedAt,
-			Expiry:       r.Expiry,
-			RequesterName: insurer.Name,
-			CompanyName:   insurer.CompanyName,
+			PatientHash:    r.PatientHash,
+			TargetHash:     r.TargetHash,
+			ReportId:       r.ReportID,
+			GrantedAt:      r.GrantedAt,
+			Expiry:         r.Expiry,
+			RequesterName:  insurer.Name,
+			CompanyName:    insurer.CompanyName,
+			Revoked:        r.Revoked,
+			RevokedAt:      r.RevokedAt,
+			GrantedFields:  r.GrantedFields,
+			AccessMode:     r.AccessMode,
+			Predicates:     toPbPredicates(r.Predicates),
+			GrantedBy:      r.GrantedBy,
+			RevokedBy:      r.RevokedBy,
+			PolicyId:       r.PolicyID,
+			ExtendedAt:     r.ExtendedAt,
+			PreviousExpiry: r.PreviousExpiry,
+			MaxReads:       r.MaxReads,
+			ReadCount:      r.ReadCount,
+			LastReadAt:     r.LastReadAt,
 		})
 	}
-	log.Printf("[Info] 查詢到授權票據: %v", tickets)
 	return &pb.ListAuthorizedTicketsResponse{
-		Success: true,
-		Tickets: tickets,
+		Success:      true,
+		Tickets:      tickets,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
 // HandleListMyReportMeta 處理病患查詢自己的報告 meta
 func HandleListMyReportMeta(
-	ctx context.Context, _ *emptypb.Empty,
+	ctx context.Context, req *pb.ListQueryRequest,
 	wallet wl.WalletInterface, builder fc.GWBuilder) (*pb.ListMyReportMetaResponse, error) {
-	
+
 	userID, err := ut.ExtractUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "無法解析 JWT")
 	}
-	log.Printf("[Debug] HandleListMyReportMeta %s", userID)
 
 	entry, ok := wallet.Get(userID)
 	if !ok {
@@ -706,7 +1413,7 @@ func HandleListMyReportMeta(
 	defer gw.Close()
 
 	// 呼叫鏈碼方法
-	result, err := contract.EvaluateTransaction("ListMyReportMeta")
+	result, err := contract.EvaluateTransaction("ListMyReportMeta", listQueryArgs(req)...)
 	if err != nil {
 		fc.PrintGatewayError(err)
 		return nil, status.Error(codes.Internal, "查詢失敗")
@@ -714,13 +1421,17 @@ func HandleListMyReportMeta(
 
 	// 解析返回的 JSON
 	type rawReportMeta struct {
-		ReportID  string `json:"reportId"`
-		ClinicID  string `json:"clinicId"`
-		CreatedAt int64  `json:"createdAt"`
+		ReportID      string `json:"reportId"`
+		ClinicID      string `json:"clinicId"`
+		ClinicName    string `json:"clinicName"`
+		CreatedAt     int64  `json:"createdAt"`
+		Version       int32  `json:"version"`
+		SchemaVersion int32  `json:"schemaVersion"`
 	}
 
 	var rawList []rawReportMeta
-	if err := json.Unmarshal(result, &rawList); err != nil {
+	page, err := parsePage(result, &rawList)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
 	}
 
@@ -728,17 +1439,37 @@ func HandleListMyReportMeta(
 	var reports []*pb.ReportMeta
 	for _, r := range rawList {
 		reports = append(reports, &pb.ReportMeta{
-			ReportId:  r.ReportID,
-			ClinicId:  r.ClinicID,
-			CreatedAt: r.CreatedAt,
+			ReportId:      r.ReportID,
+			ClinicId:      r.ClinicID,
+			ClinicName:    r.ClinicName,
+			CreatedAt:     r.CreatedAt,
+			Version:       r.Version,
+			SchemaVersion: r.SchemaVersion,
 		})
 	}
 
 	return &pb.ListMyReportMetaResponse{
-		Reports: reports,
+		Reports:      reports,
+		Bookmark:     page.Bookmark,
+		FetchedCount: page.FetchedCount,
 	}, nil
 }
 
+// readMyReport 病患讀取自己的報告內容
+func readMyReport(contract *client.Contract, reportID string) (*rawReportContent, error) {
+	result, err := contract.EvaluateTransaction("ReadMyReport", reportID)
+	if err != nil {
+		fc.PrintGatewayError(err)
+		return nil, status.Error(codes.Internal, "讀取報告失敗")
+	}
+
+	var content rawReportContent
+	if err := json.Unmarshal(result, &content); err != nil {
+		return nil, status.Errorf(codes.Internal, "回傳格式錯誤: %v", err)
+	}
+	return &content, nil
+}
+
 // HandleReadMyReport 處理病患讀取自己的完整報告內容
 func HandleReadMyReport(
 	ctx context.Context,
@@ -761,15 +1492,15 @@ func HandleReadMyReport(
 	}
 	defer gw.Close()
 
-	// 呼叫鏈碼方法
-	result, err := contract.EvaluateTransaction("ReadMyReport", req.ReportId)
+	content, err := readMyReport(contract, req.ReportId)
 	if err != nil {
-		fc.PrintGatewayError(err)
-		return nil, status.Error(codes.Internal, "讀取報告失敗")
+		return nil, err
 	}
 
 	return &pb.ReadMyReportResponse{
-		Success:    true,
-		ResultJson: string(result),
+		Success:       true,
+		ResultJson:    content.ResultJSON,
+		Version:       content.Version,
+		SchemaVersion: content.SchemaVersion,
 	}, nil
-}	
\ No newline at end of file
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	fc "go_server/fabric"
+	wl "go_server/wallet"
+)
+
+// 每次呼叫鏈碼最多處理的逾期請求筆數
+const sweepBatchSize = 50
+
+// DefaultSweepInterval 為授權請求逾期排程的預設執行間隔
+const DefaultSweepInterval = time.Hour
+
+// StartAccessRequestSweeper 以 system 身份定期呼叫鏈碼，將逾期未回應的授權請求標記為 EXPIRED
+// interval 必須大於 0，否則使用 DefaultSweepInterval
+func StartAccessRequestSweeper(
+	ctx context.Context,
+	wallet wl.WalletInterface,
+	builder fc.GWBuilder,
+	systemID string,
+	interval time.Duration) {
+
+	entry, ok := wallet.Get(systemID)
+	if !ok {
+		log.Printf("[Warning] 錢包中沒有 system 身份 %s，不啟動授權請求逾期排程", systemID)
+		return
+	}
+
+	if interval <= 0 {
+		log.Printf("[Warning] 授權請求逾期排程間隔 %s 無效，使用預設值 %s", interval, DefaultSweepInterval)
+		interval = DefaultSweepInterval
+	}
+	log.Printf("[Info] 授權請求逾期排程啟動，每 %s 執行一次", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		sweepStaleAccessRequests(entry, builder)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepStaleAccessRequests 分批處理，直到該批筆數少於 sweepBatchSize
+func sweepStaleAccessRequests(entry *wl.Entry, builder fc.GWBuilder) {
+	contract, gw, err := builder.NewContract(entry.ID, entry.Signer)
+	if err != nil {
+		log.Printf("[Warning] 授權請求逾期排程無法連接區塊鏈: %v", err)
+		return
+	}
+	defer gw.Close()
+
+	for {
+		result, err := contract.SubmitTransaction("ExpireStaleAccessRequests", strconv.Itoa(sweepBatchSize))
+		if err != nil {
+			fc.PrintGatewayError(err)
+			return
+		}
+		n, _ := strconv.Atoi(string(result))
+		if n > 0 {
+			log.Printf("[Info] 已將 %d 筆逾期授權請求標記為 EXPIRED", n)
+		}
+		if n < sweepBatchSize {
+			return
+		}
+	}
+}
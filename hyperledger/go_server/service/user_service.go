@@ -44,14 +44,13 @@ func HandleRegisterUser(ctx context.Context, req *pb.RegisterUserRequest, wallet
 	if exists {
 		return &pb.RegisterResponse{Success: false, Message: "帳號已存在"}, nil
 	}
-	
 
 	// ✅ 呼叫 Fabric CA 註冊帳號（使用 api.RegistrationRequest）
 	log.Printf("[DEBUG] 開始 Fabric CA 註冊，用戶ID: %s", req.UserId)
 	log.Printf("[DEBUG] Fabric CA URL: http://localhost:7054")
 	log.Printf("[DEBUG] 管理員證書路徑: ../orgs/org1.example.com/users/org1-admin/msp/signcerts/cert.pem")
 	log.Printf("[DEBUG] 管理員私鑰路徑: ../orgs/org1.example.com/users/org1-admin/msp/keystore/server.key")
-	
+
 	err = fc.RegisterUser(
 		"http://localhost:7054",
 		"../orgs/org1.example.com/users/org1-admin/msp/signcerts/cert.pem",
@@ -63,6 +62,7 @@ func HandleRegisterUser(ctx context.Context, req *pb.RegisterUserRequest, wallet
 			Affiliation: "org1.department1",
 			Attributes: []api.Attribute{
 				{Name: "role", Value: "patient", ECert: true},
+				{Name: "pseudonym", Value: database.Pseudonym(req.UserId), ECert: true},
 			},
 		},
 	)
@@ -177,6 +177,7 @@ func HandleRegisterInsurer(ctx context.Context, req *pb.RegisterInsurerRequest,
 			Affiliation: "org1.department2",
 			Attributes: []api.Attribute{
 				{Name: "role", Value: "insurer", ECert: true},
+				{Name: "pseudonym", Value: database.Pseudonym(req.InsurerId), ECert: true},
 			},
 		},
 	)
@@ -242,7 +243,7 @@ func HandleRegisterInsurer(ctx context.Context, req *pb.RegisterInsurerRequest,
 		log.Printf("❌ 寫入資料庫失敗: %v", err)
 		return &pb.RegisterResponse{Success: false, Message: "寫入資料庫失敗"}, nil
 	}
-	log.Printf("保險業者原始ID: %s, 雜湊後ID (存入資料庫): %s", req.InsurerId, database.HashString(req.InsurerId))
+	log.Printf("保險業者原始ID: %s, 鏈上假名: %s", req.InsurerId, database.Pseudonym(req.InsurerId))
 	log.Printf("保險業者註冊成功: %s", req.InsurerId)
 
 	return &pb.RegisterResponse{Success: true, Message: "保險業者註冊成功"}, nil
@@ -261,7 +262,7 @@ func HandleLogin(ctx context.Context, req *pb.LoginRequest, w wl.WalletInterface
 		log.Printf("查詢保險業者密碼錯誤: %v", err)
 	}
 	log.Printf("保險業者密碼查詢結果: 密碼=%s, 錯誤=%v", insurerPw, err)
-	
+
 	// 比對雜湊後的密碼
 	hashedPassword := database.HashString(req.Password)
 	if err == nil && insurerPw == hashedPassword {
@@ -284,6 +285,46 @@ func HandleLogin(ctx context.Context, req *pb.LoginRequest, w wl.WalletInterface
 		}, nil
 	}
 
+	// 檢查是否為平台管理者
+	adminPw, err := database.GetAdminPassword(req.UserId)
+	if err == nil && adminPw == hashedPassword {
+		if !w.Exists(req.UserId) {
+			log.Printf("❌ 管理者錢包不存在: %s", req.UserId)
+			return &pb.LoginResponse{Success: false, Message: "錢包不存在"}, nil
+		}
+
+		token, err := ut.GenerateJWT(req.UserId)
+		if err != nil {
+			return &pb.LoginResponse{Success: false, Message: "產生 token 失敗"}, nil
+		}
+
+		return &pb.LoginResponse{
+			Success: true,
+			Message: "管理者登入成功",
+			Token:   token,
+		}, nil
+	}
+
+	// 檢查是否為急診人員
+	staffPw, err := database.GetEmergencyStaffPassword(req.UserId)
+	if err == nil && staffPw == hashedPassword {
+		if !w.Exists(req.UserId) {
+			log.Printf("❌ 急診人員錢包不存在: %s", req.UserId)
+			return &pb.LoginResponse{Success: false, Message: "錢包不存在"}, nil
+		}
+
+		token, err := ut.GenerateJWT(req.UserId)
+		if err != nil {
+			return &pb.LoginResponse{Success: false, Message: "產生 token 失敗"}, nil
+		}
+
+		return &pb.LoginResponse{
+			Success: true,
+			Message: "急診人員登入成功",
+			Token:   token,
+		}, nil
+	}
+
 	// 再檢查是否為普通用戶帳號
 	log.Printf("檢查是否為普通用戶帳號: %s", req.UserId)
 	dbPw, err := database.GetUserPassword(req.UserId)
@@ -291,7 +332,7 @@ func HandleLogin(ctx context.Context, req *pb.LoginRequest, w wl.WalletInterface
 		log.Printf("查詢普通用戶密碼錯誤: %v", err)
 	}
 	log.Printf("普通用戶密碼查詢結果: 密碼=%s, 錯誤=%v", dbPw, err)
-	
+
 	// 比對雜湊後的密碼
 	if err != nil || dbPw != hashedPassword {
 		log.Printf("❌ 密碼驗證失敗: 用戶密碼=%s, 輸入密碼雜湊=%s", dbPw, hashedPassword)